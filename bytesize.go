@@ -0,0 +1,128 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// byteSizeUnits maps the lower case byte size suffixes to their multipliers
+var byteSizeUnits = map[string]uint64{
+	"":    1,
+	"b":   1,
+	"k":   1e3,
+	"kb":  1e3,
+	"m":   1e6,
+	"mb":  1e6,
+	"g":   1e9,
+	"gb":  1e9,
+	"t":   1e12,
+	"tb":  1e12,
+	"p":   1e15,
+	"pb":  1e15,
+	"e":   1e18,
+	"eb":  1e18,
+	"ki":  1 << 10,
+	"kib": 1 << 10,
+	"mi":  1 << 20,
+	"mib": 1 << 20,
+	"gi":  1 << 30,
+	"gib": 1 << 30,
+	"ti":  1 << 40,
+	"tib": 1 << 40,
+	"pi":  1 << 50,
+	"pib": 1 << 50,
+	"ei":  1 << 60,
+	"eib": 1 << 60,
+}
+
+// ParseByteSize parses a number of bytes with an optional decimal (kB, MB, GB, TB, PB, EB) or binary (KiB, MiB, GiB, TiB, PiB, EiB)
+// suffix, ex: 512MiB, 10MB or 1.5G. Suffixes are case insensitive, the trailing B is optional and fractional bytes are truncated.
+func ParseByteSize(s string) (uint64, error) {
+	i := 0
+	for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+		i++
+	}
+	number, unit := s[:i], strings.ToLower(strings.TrimSpace(s[i:]))
+
+	whole, fraction, hasFraction := strings.Cut(number, ".")
+	if whole == "" || hasFraction && fraction == "" || strings.Contains(fraction, ".") {
+		return 0, errors.New("invalid byte size")
+	}
+	multiplier, ok := byteSizeUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("unknown byte size unit %q", strings.TrimSpace(s[i:]))
+	}
+
+	n, _ := new(big.Int).SetString(whole+fraction, 10)
+	n.Mul(n, new(big.Int).SetUint64(multiplier))
+	n.Quo(n, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(fraction))), nil))
+	if !n.IsUint64() {
+		return 0, errors.New("byte size out of range")
+	}
+
+	return n.Uint64(), nil
+}
+
+// GetByteSize returns a number of bytes parsed with ParseByteSize from environment variable or the default value
+func GetByteSize(key string, defaultValue uint64) uint64 {
+	return defaultReader.GetByteSize(key, defaultValue)
+}
+
+// GetByteSizeSlice returns a slice of numbers of bytes parsed with ParseByteSize from environment variable or the default value
+func GetByteSizeSlice(key, sep string, defaultValue []uint64) []uint64 {
+	return defaultReader.GetByteSizeSlice(key, sep, defaultValue)
+}
+
+// LookupByteSize returns a number of bytes parsed with ParseByteSize from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupByteSize(key string, defaultValue uint64) (uint64, error) {
+	return defaultReader.LookupByteSize(key, defaultValue)
+}
+
+// LookupByteSizeSlice returns a slice of numbers of bytes parsed with ParseByteSize from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupByteSizeSlice(key, sep string, defaultValue []uint64) ([]uint64, error) {
+	return defaultReader.LookupByteSizeSlice(key, sep, defaultValue)
+}
+
+// MustByteSize returns a number of bytes parsed with ParseByteSize from environment variable, it panics if the variable is not set or invalid
+func MustByteSize(key string) uint64 {
+	return defaultReader.MustByteSize(key)
+}
+
+// MustByteSizeSlice returns a slice of numbers of bytes parsed with ParseByteSize from environment variable, it panics if the variable is not set or invalid
+func MustByteSizeSlice(key, sep string) []uint64 {
+	return defaultReader.MustByteSizeSlice(key, sep)
+}
+
+// GetByteSize returns a number of bytes parsed with ParseByteSize from environment variable or the default value
+func (r *Reader) GetByteSize(key string, defaultValue uint64) uint64 {
+	value, _ := r.LookupByteSize(key, defaultValue)
+	return value
+}
+
+// GetByteSizeSlice returns a slice of numbers of bytes parsed with ParseByteSize from environment variable or the default value
+func (r *Reader) GetByteSizeSlice(key, sep string, defaultValue []uint64) []uint64 {
+	value, _ := r.LookupByteSizeSlice(key, sep, defaultValue)
+	return value
+}
+
+// LookupByteSize returns a number of bytes parsed with ParseByteSize from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupByteSize(key string, defaultValue uint64) (uint64, error) {
+	return lookupWith(r, key, defaultValue, "byte size", ParseByteSize)
+}
+
+// LookupByteSizeSlice returns a slice of numbers of bytes parsed with ParseByteSize from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupByteSizeSlice(key, sep string, defaultValue []uint64) ([]uint64, error) {
+	return lookupSliceWith(r, key, sep, defaultValue, "byte sizes", ParseByteSize)
+}
+
+// MustByteSize returns a number of bytes parsed with ParseByteSize from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustByteSize(key string) uint64 {
+	return must(r.required().LookupByteSize(key, 0))
+}
+
+// MustByteSizeSlice returns a slice of numbers of bytes parsed with ParseByteSize from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustByteSizeSlice(key, sep string) []uint64 {
+	return must(r.required().LookupByteSizeSlice(key, sep, nil))
+}
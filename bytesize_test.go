@@ -0,0 +1,85 @@
+package env
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseByteSize(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		kind          string
+		value         string
+		expectedValue uint64
+		expectedError bool
+	}{
+		{"test-plain-bytes", "1024", 1024, false},
+		{"test-bytes-suffix", "512B", 512, false},
+		{"test-decimal", "10MB", 10000000, false},
+		{"test-decimal-short", "1.5G", 1500000000, false},
+		{"test-binary", "512MiB", 512 << 20, false},
+		{"test-binary-short", "2Gi", 2 << 30, false},
+		{"test-case-insensitive", "1kb", 1000, false},
+		{"test-space-before-unit", "64 KiB", 64 << 10, false},
+		{"test-fraction-truncated", "1.1KiB", 1126, false},
+		{"test-max", "18446744073709551615", 18446744073709551615, false},
+		{"test-overflow", "16EiB", 0, true},
+		{"test-overflow-digits", "18446744073709551616", 0, true},
+		{"test-unknown-unit", "10XB", 0, true},
+		{"test-negative", "-1MB", 0, true},
+		{"test-missing-number", "MB", 0, true},
+		{"test-missing-fraction", "1.MB", 0, true},
+		{"test-missing-whole", ".5MB", 0, true},
+		{"test-two-dots", "1.2.3MB", 0, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := ParseByteSize(tt.value)
+			if result != tt.expectedValue {
+				t.Errorf("ParseByteSize(%q): expected %d, actual %d", tt.value, tt.expectedValue, result)
+			}
+			if (err != nil) != tt.expectedError {
+				t.Errorf("ParseByteSize(%q): expected error %v, actual %v", tt.value, tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestReaderGetByteSize(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"MAX_BODY":    "10MB",
+		"CACHE_SIZE":  "512MiB",
+		"INVALID":     "10 megabytes",
+		"TIERS":       "1MiB,10MiB,1.5GB",
+		"TIERS_WRONG": "1MiB,x",
+	})
+
+	if result := r.GetByteSize("MAX_BODY", 0); result != 10000000 {
+		t.Errorf("r.GetByteSize(\"MAX_BODY\", 0): expected %d, actual %d", 10000000, result)
+	}
+	if result := r.GetByteSize("CACHE_SIZE", 0); result != 512<<20 {
+		t.Errorf("r.GetByteSize(\"CACHE_SIZE\", 0): expected %d, actual %d", 512<<20, result)
+	}
+	if result := r.GetByteSize("INVALID", 1); result != 1 {
+		t.Errorf("r.GetByteSize(\"INVALID\", 1): expected %d, actual %d", 1, result)
+	}
+
+	expected := []uint64{1 << 20, 10 << 20, 1500000000}
+	if result := r.GetByteSizeSlice("TIERS", ",", nil); !reflect.DeepEqual(result, expected) {
+		t.Errorf("r.GetByteSizeSlice(\"TIERS\", \",\", nil): expected %v, actual %v", expected, result)
+	}
+
+	var parseErr *ParseError
+	if _, err := r.LookupByteSizeSlice("TIERS_WRONG", ",", nil); !errors.As(err, &parseErr) || parseErr.Type != "byte sizes" {
+		t.Errorf("r.LookupByteSizeSlice(\"TIERS_WRONG\", \",\", nil): expected *ParseError, actual %v", err)
+	}
+	expectPanic(t, `env: parsing "10 megabytes" from INVALID as byte size: unknown byte size unit "megabytes"`, func() { r.MustByteSize("INVALID") })
+}
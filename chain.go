@@ -0,0 +1,59 @@
+package env
+
+// Layer is a named Source used by a Chain, the name identifies the layer when debugging
+type Layer struct {
+	Name   string
+	Source Source
+}
+
+// Chain is a Source that looks up the keys in its layers in order, the first layer where the key is set wins.
+// A layer that fails to read the key, like a DirSource with an unreadable file, stops the lookup instead of falling through.
+type Chain struct {
+	layers []Layer
+}
+
+// NewChain returns a Chain with the given layers, from the highest to the lowest precedence
+func NewChain(layers ...Layer) *Chain {
+	return &Chain{layers: layers}
+}
+
+// Lookup returns the value of the key from the first layer where it is set
+func (c *Chain) Lookup(key string) (string, bool) {
+	value, _, ok, err := c.lookup(key)
+	return value, ok && err == nil
+}
+
+// LookupErr returns the value of the key from the first layer where it is set, or the error of the first layer that fails to read it
+func (c *Chain) LookupErr(key string) (string, bool, error) {
+	value, _, ok, err := c.lookup(key)
+	return value, ok, err
+}
+
+// Origin returns the name of the layer that supplies the value of the key, or of the layer that fails to read it
+func (c *Chain) Origin(key string) (string, bool) {
+	_, name, ok, err := c.lookup(key)
+	return name, ok || err != nil
+}
+
+// Origins returns the name of the layer that supplies the value of each key, keys that are not set are omitted
+func (c *Chain) Origins(keys ...string) map[string]string {
+	origins := make(map[string]string, len(keys))
+	for _, key := range keys {
+		if name, ok := c.Origin(key); ok {
+			origins[key] = name
+		}
+	}
+
+	return origins
+}
+
+func (c *Chain) lookup(key string) (string, string, bool, error) {
+	for _, layer := range c.layers {
+		value, ok, err := lookupSource(layer.Source, key)
+		if err != nil || ok {
+			return value, layer.Name, ok, err
+		}
+	}
+
+	return "", "", false, nil
+}
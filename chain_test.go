@@ -0,0 +1,130 @@
+package env
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+
+	secrets := t.TempDir()
+	if err := os.WriteFile(filepath.Join(secrets, "DB_PASSWORD"), []byte("secret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	chain := NewChain(
+		Layer{Name: "environment", Source: MapSource{"WORKERS": "8"}},
+		Layer{Name: ".env", Source: MapSource{"WORKERS": "2", "PORT": "9090"}},
+		Layer{Name: "secrets", Source: DirSource(secrets)},
+		Layer{Name: "defaults", Source: MapSource{"PORT": "8080", "HOST": "localhost"}},
+	)
+	r := NewReader(chain)
+
+	var tests = []struct {
+		kind           string
+		key            string
+		expectedValue  string
+		expectedOrigin string
+	}{
+		{"test-first-layer", "WORKERS", "8", "environment"},
+		{"test-second-layer", "PORT", "9090", ".env"},
+		{"test-secrets-layer", "DB_PASSWORD", "secret", "secrets"},
+		{"test-last-layer", "HOST", "localhost", "defaults"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			if result := r.GetString(tt.key, ""); result != tt.expectedValue {
+				t.Errorf("r.GetString(\"%s\", \"\"): expected %q, actual %q", tt.key, tt.expectedValue, result)
+			}
+			if origin, ok := chain.Origin(tt.key); !ok || origin != tt.expectedOrigin {
+				t.Errorf("chain.Origin(\"%s\"): expected %q, actual %q", tt.key, tt.expectedOrigin, origin)
+			}
+		})
+	}
+
+	if result := r.GetInt("UNSET", 4); result != 4 {
+		t.Errorf("r.GetInt(\"UNSET\", 4): expected %d, actual %d", 4, result)
+	}
+	if _, ok := chain.Origin("UNSET"); ok {
+		t.Error("chain.Origin(\"UNSET\"): expected unset")
+	}
+
+	expected := map[string]string{"WORKERS": "environment", "HOST": "defaults"}
+	if result := chain.Origins("WORKERS", "HOST", "UNSET"); !reflect.DeepEqual(result, expected) {
+		t.Errorf("chain.Origins(): expected %#v, actual %#v", expected, result)
+	}
+}
+
+func TestChainUnreadableSecret(t *testing.T) {
+	t.Parallel()
+
+	secrets := t.TempDir()
+	if err := os.WriteFile(filepath.Join(secrets, "DB_PASSWORD"), make([]byte, DefaultFileLimit+1), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	chain := NewChain(
+		Layer{Name: "secrets", Source: DirSource(secrets)},
+		Layer{Name: "defaults", Source: MapSource{"DB_PASSWORD": "changeme"}},
+	)
+
+	result, err := NewReader(chain).LookupString("DB_PASSWORD", "")
+	var fileErr *FileError
+	if !errors.As(err, &fileErr) || !errors.Is(err, ErrFileTooLarge) {
+		t.Fatalf("LookupString(\"DB_PASSWORD\", \"\"): expected *FileError wrapping ErrFileTooLarge, actual %v", err)
+	}
+	if result != "" {
+		t.Errorf("LookupString(\"DB_PASSWORD\", \"\"): expected %q, actual %q", "", result)
+	}
+	if value, ok := chain.Lookup("DB_PASSWORD"); ok {
+		t.Errorf("chain.Lookup(\"DB_PASSWORD\"): expected unset, actual %q", value)
+	}
+	if origin, ok := chain.Origin("DB_PASSWORD"); !ok || origin != "secrets" {
+		t.Errorf("chain.Origin(\"DB_PASSWORD\"): expected %q, actual %q", "secrets", origin)
+	}
+}
+
+func TestDirSource(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "API_KEY"), []byte("key\r\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "MULTILINE"), []byte("line1\nline2\n\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		kind          string
+		key           string
+		expectedValue string
+		expectedOk    bool
+	}{
+		{"test-trailing-crlf", "API_KEY", "key", true},
+		{"test-single-trailing-newline", "MULTILINE", "line1\nline2\n", true},
+		{"test-missing-file", "MISSING", "", false},
+		{"test-path-traversal", "../API_KEY", "", false},
+		{"test-parent-directory", "..", "", false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			value, ok := DirSource(dir).Lookup(tt.key)
+			if value != tt.expectedValue || ok != tt.expectedOk {
+				t.Errorf("DirSource.Lookup(\"%s\"): expected (%q, %v), actual (%q, %v)", tt.key, tt.expectedValue, tt.expectedOk, value, ok)
+			}
+		})
+	}
+}
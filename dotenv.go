@@ -0,0 +1,227 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// DotenvError is returned when a dotenv file has a syntax error
+type DotenvError struct {
+	File string // file name, empty when parsed from an io.Reader
+	Line int    // line number, starting at 1
+	Msg  string // description of the error
+}
+
+func (e *DotenvError) Error() string {
+	if e.File == "" {
+		return fmt.Sprintf("env: dotenv line %d: %s", e.Line, e.Msg)
+	}
+
+	return fmt.Sprintf("env: %s:%d: %s", e.File, e.Line, e.Msg)
+}
+
+// ParseDotenv parses the dotenv content of r and returns the variables it defines.
+//
+// Each line has the form KEY=value, optionally prefixed by export. Blank lines and lines starting with # are ignored.
+// Values can be:
+//
+//	unquoted       KEY=value # inline comments need a whitespace before the #
+//	single quoted  KEY='value' is taken literally
+//	double quoted  KEY="value" supports the \n, \r, \t, \", \\ and \$ escape sequences and can span multiple lines
+func ParseDotenv(r io.Reader) (map[string]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	values := make(map[string]string)
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimLeft(lines[i], " \t")
+		if trimmed := strings.TrimSpace(line); trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "export ") || strings.HasPrefix(line, "export\t") {
+			line = strings.TrimLeft(line[len("export"):], " \t")
+		}
+
+		idx := strings.IndexByte(line, '=')
+		if idx < 0 {
+			return nil, &DotenvError{Line: lineNumber, Msg: "missing '=' after the variable name"}
+		}
+
+		key := strings.TrimRight(line[:idx], " \t")
+		if !isValidDotenvKey(key) {
+			return nil, &DotenvError{Line: lineNumber, Msg: fmt.Sprintf("invalid variable name %q", key)}
+		}
+
+		var value string
+		rest := strings.TrimLeft(line[idx+1:], " \t")
+		switch {
+		case strings.HasPrefix(rest, "'"):
+			end := strings.IndexByte(rest[1:], '\'')
+			if end < 0 {
+				return nil, &DotenvError{Line: lineNumber, Msg: "unterminated single-quoted value"}
+			}
+			value, rest = rest[1:end+1], rest[end+2:]
+		case strings.HasPrefix(rest, `"`):
+			value, rest, i, err = parseDoubleQuoted(lines, i, rest[1:])
+			if err != nil {
+				return nil, err
+			}
+		default:
+			value, rest = trimInlineComment(rest), ""
+		}
+
+		if rest = strings.TrimSpace(rest); rest != "" && !strings.HasPrefix(rest, "#") {
+			return nil, &DotenvError{Line: i + 1, Msg: fmt.Sprintf("unexpected %q after the quoted value", rest)}
+		}
+
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+// ReadDotenv parses the dotenv file and returns the variables it defines, see ParseDotenv for the syntax
+func ReadDotenv(filename string) (map[string]string, error) {
+	f, err := os.Open(filename) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() //nolint:errcheck
+
+	values, err := ParseDotenv(f)
+	var dotenvErr *DotenvError
+	if errors.As(err, &dotenvErr) {
+		dotenvErr.File = filename
+	}
+
+	return values, err
+}
+
+// LoadDotenv reads the dotenv files, .env when none is given, and sets their variables in the process environment.
+// Variables already set in the process environment are not overridden, when a variable is defined in more than one file the first file wins.
+func LoadDotenv(filenames ...string) error {
+	values, err := readDotenvFiles(filenames)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range values {
+		if _, ok := os.LookupEnv(key); ok {
+			continue
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DotenvSource reads the dotenv files, .env when none is given, and returns a Source with their variables.
+// When a variable is defined in more than one file the first file wins.
+func DotenvSource(filenames ...string) (MapSource, error) {
+	values, err := readDotenvFiles(filenames)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapSource(values), nil
+}
+
+func readDotenvFiles(filenames []string) (map[string]string, error) {
+	if len(filenames) == 0 {
+		filenames = []string{".env"}
+	}
+
+	values := make(map[string]string)
+	for _, filename := range filenames {
+		fileValues, err := ReadDotenv(filename)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range fileValues {
+			if _, ok := values[key]; !ok {
+				values[key] = value
+			}
+		}
+	}
+
+	return values, nil
+}
+
+func isValidDotenvKey(key string) bool {
+	if key == "" {
+		return false
+	}
+
+	for i, c := range key {
+		switch {
+		case c == '_' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z':
+		case i > 0 && (c >= '0' && c <= '9' || c == '.' || c == '-'):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// trimInlineComment removes a trailing comment, which must be preceded by a whitespace, and the surrounding whitespaces
+func trimInlineComment(value string) string {
+	for i := 1; i < len(value); i++ {
+		if value[i] == '#' && (value[i-1] == ' ' || value[i-1] == '\t') {
+			value = value[:i]
+			break
+		}
+	}
+
+	return strings.TrimSpace(value)
+}
+
+// parseDoubleQuoted parses a double-quoted value starting at s, which is the remainder of lines[i] after the opening quote.
+// It returns the unescaped value, the remainder of the line after the closing quote and the index of that line.
+func parseDoubleQuoted(lines []string, i int, s string) (string, string, int, error) {
+	startLine := i + 1
+
+	var sb strings.Builder
+	for {
+		for j := 0; j < len(s); j++ {
+			switch c := s[j]; {
+			case c == '"':
+				return sb.String(), s[j+1:], i, nil
+			case c == '\\' && j+1 < len(s):
+				j++
+				switch s[j] {
+				case 'n':
+					sb.WriteByte('\n')
+				case 'r':
+					sb.WriteByte('\r')
+				case 't':
+					sb.WriteByte('\t')
+				case '"', '\\', '$':
+					sb.WriteByte(s[j])
+				default:
+					sb.WriteByte('\\')
+					sb.WriteByte(s[j])
+				}
+			default:
+				sb.WriteByte(c)
+			}
+		}
+
+		if i+1 >= len(lines) {
+			return "", "", i, &DotenvError{Line: startLine, Msg: "unterminated double-quoted value"}
+		}
+		sb.WriteByte('\n')
+		i++
+		s = lines[i]
+	}
+}
@@ -0,0 +1,154 @@
+package env
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseDotenv(t *testing.T) {
+	t.Parallel()
+
+	content := `# comment
+PLAIN=value
+SPACES = value with spaces
+export EXPORTED=exported
+INLINE_COMMENT=value # comment
+HASH=value#not-a-comment
+EMPTY=
+SINGLE='single # quoted \n $HOME'
+DOUBLE="double \"quoted\"\t\\ \$HOME\n"
+DOUBLE_COMMENT="value" # comment
+MULTILINE="first line
+second line"
+WINDOWS=crlf` + "\r\n" + `
+  INDENTED=indented
+DOTTED.KEY-NAME=dotted
+`
+
+	values, err := ParseDotenv(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("ParseDotenv(): unexpected error %v", err)
+	}
+
+	expected := map[string]string{
+		"PLAIN":           "value",
+		"SPACES":          "value with spaces",
+		"EXPORTED":        "exported",
+		"INLINE_COMMENT":  "value",
+		"HASH":            "value#not-a-comment",
+		"EMPTY":           "",
+		"SINGLE":          `single # quoted \n $HOME`,
+		"DOUBLE":          "double \"quoted\"\t\\ $HOME\n",
+		"DOUBLE_COMMENT":  "value",
+		"MULTILINE":       "first line\nsecond line",
+		"WINDOWS":         "crlf",
+		"INDENTED":        "indented",
+		"DOTTED.KEY-NAME": "dotted",
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("ParseDotenv(): expected %#v, actual %#v", expected, values)
+	}
+}
+
+func TestParseDotenvErrors(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		kind          string
+		content       string
+		expectedError string
+	}{
+		{"test-missing-equal", "KEY=value\nINVALID", "env: dotenv line 2: missing '=' after the variable name"},
+		{"test-invalid-key", "1KEY=value", `env: dotenv line 1: invalid variable name "1KEY"`},
+		{"test-empty-key", "=value", `env: dotenv line 1: invalid variable name ""`},
+		{"test-unterminated-single-quote", "\nKEY='value", "env: dotenv line 2: unterminated single-quoted value"},
+		{"test-unterminated-double-quote", "KEY=\"value\nOTHER=value", "env: dotenv line 1: unterminated double-quoted value"},
+		{"test-trailing-characters", "KEY=\"value\" trailing", `env: dotenv line 1: unexpected "trailing" after the quoted value`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseDotenv(strings.NewReader(tt.content))
+			if err == nil || err.Error() != tt.expectedError {
+				t.Errorf("ParseDotenv(%q): expected %q, actual %v", tt.content, tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func writeDotenv(t *testing.T, content string) string {
+	t.Helper()
+
+	filename := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(filename, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return filename
+}
+
+func TestReadDotenv(t *testing.T) {
+	t.Parallel()
+
+	filename := writeDotenv(t, "KEY=value\nINVALID\n")
+
+	_, err := ReadDotenv(filename)
+
+	var dotenvErr *DotenvError
+	if !errors.As(err, &dotenvErr) || dotenvErr.File != filename || dotenvErr.Line != 2 {
+		t.Errorf("ReadDotenv(%q): expected *DotenvError, actual %v", filename, err)
+	}
+
+	if _, err := ReadDotenv(filepath.Join(t.TempDir(), "missing.env")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadDotenv(\"missing.env\"): expected os.ErrNotExist, actual %v", err)
+	}
+}
+
+func TestLoadDotenv(t *testing.T) {
+	t.Setenv("DOTENV_EXISTING", "from-environment")
+
+	first := writeDotenv(t, "DOTENV_EXISTING=from-file\nDOTENV_LOADED=first\n")
+	second := writeDotenv(t, "DOTENV_LOADED=second\nDOTENV_SECOND=second\n")
+
+	if err := LoadDotenv(first, second); err != nil {
+		t.Fatalf("LoadDotenv(): unexpected error %v", err)
+	}
+	defer os.Unsetenv("DOTENV_LOADED") //nolint:errcheck
+	defer os.Unsetenv("DOTENV_SECOND") //nolint:errcheck
+
+	for key, expected := range map[string]string{
+		"DOTENV_EXISTING": "from-environment",
+		"DOTENV_LOADED":   "first",
+		"DOTENV_SECOND":   "second",
+	} {
+		if result := GetString(key, ""); result != expected {
+			t.Errorf("GetString(\"%s\", \"\"): expected %q, actual %q", key, expected, result)
+		}
+	}
+}
+
+func TestDotenvSource(t *testing.T) {
+	t.Parallel()
+
+	filename := writeDotenv(t, "PORT=8080\nHOSTS=\"a,b\"\n")
+
+	source, err := DotenvSource(filename)
+	if err != nil {
+		t.Fatalf("DotenvSource(%q): unexpected error %v", filename, err)
+	}
+
+	r := NewReader(source)
+	if result := r.GetInt("PORT", 80); result != 8080 {
+		t.Errorf("r.GetInt(\"PORT\", 80): expected %d, actual %d", 8080, result)
+	}
+	if result := r.GetStringSlice("HOSTS", ",", nil); !reflect.DeepEqual(result, []string{"a", "b"}) {
+		t.Errorf("r.GetStringSlice(\"HOSTS\", \",\", nil): expected %#v, actual %#v", []string{"a", "b"}, result)
+	}
+}
@@ -0,0 +1,95 @@
+package env
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseDuration parses a duration string with the time.ParseDuration syntax, ex: 1m30s or 250ms,
+// also accepting the d (24h) and w (7d) units, ex: 1w2d or 1.5d
+func ParseDuration(s string) (time.Duration, error) {
+	if !strings.ContainsAny(s, "dw") {
+		return time.ParseDuration(s)
+	}
+
+	invalid := fmt.Errorf("invalid duration %q", s)
+	value := s
+	negative := false
+	if value != "" && (value[0] == '-' || value[0] == '+') {
+		negative = value[0] == '-'
+		value = value[1:]
+	}
+	if value == "" {
+		return 0, invalid
+	}
+
+	var total time.Duration
+	for value != "" {
+		i := 0
+		for i < len(value) && (value[i] == '.' || value[i] >= '0' && value[i] <= '9') {
+			i++
+		}
+		j := i
+		for j < len(value) && value[j] != '.' && (value[j] < '0' || value[j] > '9') {
+			j++
+		}
+		number, unit := value[:i], value[i:j]
+		value = value[j:]
+
+		var part time.Duration
+		switch unit {
+		case "d", "w":
+			result, err := strconv.ParseFloat(number, 64)
+			if err != nil {
+				return 0, invalid
+			}
+			multiplier := 24 * time.Hour
+			if unit == "w" {
+				multiplier *= 7
+			}
+			if result*float64(multiplier) >= math.MaxInt64 {
+				return 0, fmt.Errorf("invalid duration %q: out of range", s)
+			}
+			part = time.Duration(result * float64(multiplier))
+		default:
+			result, err := time.ParseDuration(number + unit)
+			if err != nil {
+				return 0, invalid
+			}
+			part = result
+		}
+
+		if total > math.MaxInt64-part {
+			return 0, fmt.Errorf("invalid duration %q: out of range", s)
+		}
+		total += part
+	}
+
+	if negative {
+		total = -total
+	}
+
+	return total, nil
+}
+
+// parseDuration parses s with ParseDuration, plain integers are multiplied by unit when it is not zero
+func parseDuration(s string, unit time.Duration) (time.Duration, error) {
+	if unit == 0 {
+		return ParseDuration(s)
+	}
+
+	value, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return ParseDuration(s)
+	}
+
+	result := time.Duration(value) * unit
+	if value != 0 && result/time.Duration(value) != unit {
+		return 0, fmt.Errorf("invalid duration %q: out of range", s)
+	}
+
+	return result, nil
+}
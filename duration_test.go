@@ -0,0 +1,101 @@
+package env
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		kind          string
+		value         string
+		expectedValue time.Duration
+		expectedError bool
+	}{
+		{"test-standard-syntax", "1m30s", 90 * time.Second, false},
+		{"test-milliseconds", "250ms", 250 * time.Millisecond, false},
+		{"test-days", "2d", 48 * time.Hour, false},
+		{"test-weeks", "1w", 7 * 24 * time.Hour, false},
+		{"test-fractional-days", "1.5d", 36 * time.Hour, false},
+		{"test-mixed-units", "1w2d3h4m5s6ns", 9*24*time.Hour + 3*time.Hour + 4*time.Minute + 5*time.Second + 6, false},
+		{"test-negative", "-1d12h", -36 * time.Hour, false},
+		{"test-invalid", "1x", 0, true},
+		{"test-missing-unit", "1d5", 0, true},
+		{"test-missing-number", "d", 0, true},
+		{"test-sign-only", "-", 0, true},
+		{"test-out-of-range", "100000w", 0, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := ParseDuration(tt.value)
+			if result != tt.expectedValue {
+				t.Errorf("ParseDuration(%q): expected %v, actual %v", tt.value, tt.expectedValue, result)
+			}
+			if (err != nil) != tt.expectedError {
+				t.Errorf("ParseDuration(%q): expected error %v, actual %v", tt.value, tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestReaderGetDuration(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"TIMEOUT":  "1m30s",
+		"INTERVAL": "10",
+		"TTL":      "2d",
+		"INVALID":  "1m30",
+		"OVERFLOW": "9223372036854775807",
+		"BACKOFF":  "250ms,1s,2",
+		"RETRIES":  "1s,x",
+	})
+
+	var tests = []struct {
+		kind          string
+		key           string
+		expectedValue time.Duration
+	}{
+		{"test-duration-syntax", "TIMEOUT", 90 * time.Second},
+		{"test-plain-integer", "INTERVAL", 10 * time.Second},
+		{"test-extended-units", "TTL", 48 * time.Hour},
+		{"test-invalid-value", "INVALID", 5 * time.Second},
+		{"test-overflow", "OVERFLOW", 5 * time.Second},
+		{"test-default-value", "UNSET", 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			if result := r.GetDuration(tt.key, 5, time.Second); result != tt.expectedValue {
+				t.Errorf("r.GetDuration(\"%s\", 5, 1s): expected %v, actual %v", tt.key, tt.expectedValue, result)
+			}
+		})
+	}
+
+	expected := []time.Duration{250 * time.Millisecond, time.Second, 2 * time.Second}
+	if result := r.GetDurationSlice("BACKOFF", ",", nil, time.Second); !reflect.DeepEqual(result, expected) {
+		t.Errorf("r.GetDurationSlice(\"BACKOFF\", \",\", nil, 1s): expected %v, actual %v", expected, result)
+	}
+	if _, err := r.LookupDurationSlice("BACKOFF", ",", nil, 0); err == nil {
+		t.Error("r.LookupDurationSlice(\"BACKOFF\", \",\", nil, 0): expected plain integers to be rejected without a unit")
+	}
+
+	var parseErr *ParseError
+	if _, err := r.LookupDurationSlice("RETRIES", ",", nil, time.Second); !errors.As(err, &parseErr) || parseErr.Type != "[]time.Duration" {
+		t.Errorf("r.LookupDurationSlice(\"RETRIES\", \",\", nil, 1s): expected *ParseError, actual %v", err)
+	}
+	if result := GetFrom(r, "TTL", time.Hour); result != 48*time.Hour {
+		t.Errorf("GetFrom(r, \"TTL\", 1h): expected %v, actual %v", 48*time.Hour, result)
+	}
+}
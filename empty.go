@@ -0,0 +1,31 @@
+package env
+
+// EmptyPolicy defines how the functions handle environment variables set to an empty value
+type EmptyPolicy int
+
+const (
+	// EmptyAsSet treats an empty value as set, GetString returns "" and the numeric functions fail to parse it
+	EmptyAsSet EmptyPolicy = iota
+	// EmptyAsUnset treats an empty value as unset, the functions return the default value and Must functions panic with a *NotSetError
+	EmptyAsUnset
+	// EmptyReject rejects an empty value with an *EmptyError
+	EmptyReject
+)
+
+// SetEmptyPolicy sets the policy of the package level functions, it is not safe to call concurrently with them
+// and should be called during the program initialization
+func SetEmptyPolicy(policy EmptyPolicy) {
+	defaultReader = defaultReader.WithEmptyPolicy(policy)
+}
+
+// WithEmptyPolicy returns a reader that handles empty values with the policy
+func WithEmptyPolicy(policy EmptyPolicy) *Reader {
+	return defaultReader.WithEmptyPolicy(policy)
+}
+
+// WithEmptyPolicy returns a copy of the reader that handles empty values with the policy
+func (r *Reader) WithEmptyPolicy(policy EmptyPolicy) *Reader {
+	c := *r
+	c.emptyPolicy = policy
+	return &c
+}
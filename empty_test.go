@@ -0,0 +1,93 @@
+package env
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReaderWithEmptyPolicy(t *testing.T) {
+	t.Parallel()
+
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	if err := os.WriteFile(secretFile, []byte("s3cr3t\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	values := map[string]string{
+		"HOST":          "",
+		"PORTS":         "",
+		"SECRET":        "",
+		"SECRET_FILE":   secretFile,
+		"EXPANDS_EMPTY": "${UNSET}",
+	}
+
+	var tests = []struct {
+		kind          string
+		policy        EmptyPolicy
+		expectedHost  string
+		expectedPorts []int
+		expectedError error
+	}{
+		{"test-empty-as-set", EmptyAsSet, "", []int{80}, &ParseError{}},
+		{"test-empty-as-unset", EmptyAsUnset, "localhost", []int{80}, nil},
+		{"test-empty-reject", EmptyReject, "localhost", []int{80}, &EmptyError{}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewMapReader(values).WithEmptyPolicy(tt.policy)
+			if result := r.GetString("HOST", "localhost"); result != tt.expectedHost {
+				t.Errorf("r.GetString(\"HOST\", \"localhost\"): expected %q, actual %q", tt.expectedHost, result)
+			}
+			if result := r.GetIntSlice("PORTS", ",", []int{80}); !reflect.DeepEqual(result, tt.expectedPorts) {
+				t.Errorf("r.GetIntSlice(\"PORTS\", \",\", [80]): expected %v, actual %v", tt.expectedPorts, result)
+			}
+
+			_, err := r.LookupIntSlice("PORTS", ",", nil)
+			if tt.expectedError == nil && err != nil || tt.expectedError != nil && reflect.TypeOf(err) != reflect.TypeOf(tt.expectedError) {
+				t.Errorf("r.LookupIntSlice(\"PORTS\", \",\", nil): expected %T, actual %v", tt.expectedError, err)
+			}
+		})
+	}
+
+	r := NewMapReader(values).WithEmptyPolicy(EmptyAsUnset)
+	expectPanic(t, "env: HOST is not set", func() { r.MustString("HOST") })
+	if result := r.WithExpansion().GetString("EXPANDS_EMPTY", "default"); result != "default" {
+		t.Errorf("r.WithExpansion().GetString(\"EXPANDS_EMPTY\", \"default\"): expected %q, actual %q", "default", result)
+	}
+	if result := r.WithFileFallback(0).GetString("SECRET", ""); result != "s3cr3t" {
+		t.Errorf("r.WithFileFallback(0).GetString(\"SECRET\", \"\"): expected %q, actual %q", "s3cr3t", result)
+	}
+
+	type config struct {
+		Host string `env:"HOST" required:"true"`
+	}
+	var notSetErr *NotSetError
+	if err := r.Load(&config{}); !errors.As(err, &notSetErr) {
+		t.Errorf("r.Load(&config{}): expected *NotSetError, actual %v", err)
+	}
+	var emptyErr *EmptyError
+	if err := NewMapReader(values).WithEmptyPolicy(EmptyReject).Load(&config{}); !errors.As(err, &emptyErr) || err.Error() != "env: HOST is empty" {
+		t.Errorf("r.Load(&config{}) with EmptyReject: expected *EmptyError, actual %v", err)
+	}
+}
+
+func TestSetEmptyPolicy(t *testing.T) {
+	t.Setenv("SET_EMPTY_POLICY_HOST", "")
+
+	SetEmptyPolicy(EmptyAsUnset)
+	defer SetEmptyPolicy(EmptyAsSet)
+
+	if result := GetString("SET_EMPTY_POLICY_HOST", "localhost"); result != "localhost" {
+		t.Errorf("GetString(\"SET_EMPTY_POLICY_HOST\", \"localhost\"): expected %q, actual %q", "localhost", result)
+	}
+	if result := WithPrefix("SET_EMPTY_POLICY_").GetString("HOST", "localhost"); result != "localhost" {
+		t.Errorf("WithPrefix(\"SET_EMPTY_POLICY_\").GetString(\"HOST\", \"localhost\"): expected %q, actual %q", "localhost", result)
+	}
+}
@@ -0,0 +1,143 @@
+package env
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// Encoding is the binary-to-text encoding of a value read by the encoded bytes functions
+type Encoding int
+
+const (
+	// Base64Std is the standard padded base64 encoding, base64.StdEncoding
+	Base64Std Encoding = iota
+	// Base64URL is the URL-safe padded base64 encoding, base64.URLEncoding
+	Base64URL
+	// Base64RawStd is the standard unpadded base64 encoding, base64.RawStdEncoding
+	Base64RawStd
+	// Base64RawURL is the URL-safe unpadded base64 encoding used by JWTs, base64.RawURLEncoding
+	Base64RawURL
+	// Hex is the hexadecimal encoding, case insensitive
+	Hex
+	// Base32Std is the standard padded base32 encoding, base32.StdEncoding
+	Base32Std
+	// AutoEncoding decodes values made only of an even number of hexadecimal digits as Hex and the other values
+	// with the base64 variant that accepts them. Base32 is never detected.
+	AutoEncoding
+)
+
+// String returns the name of the encoding
+func (e Encoding) String() string {
+	switch e {
+	case Base64Std:
+		return "base64"
+	case Base64URL:
+		return "base64url"
+	case Base64RawStd:
+		return "raw base64"
+	case Base64RawURL:
+		return "raw base64url"
+	case Hex:
+		return "hex"
+	case Base32Std:
+		return "base32"
+	case AutoEncoding:
+		return "base64 or hex"
+	}
+
+	return fmt.Sprintf("Encoding(%d)", int(e))
+}
+
+// Decode returns the bytes represented by s in the encoding
+func (e Encoding) Decode(s string) ([]byte, error) {
+	switch e {
+	case Base64Std:
+		return base64.StdEncoding.DecodeString(s)
+	case Base64URL:
+		return base64.URLEncoding.DecodeString(s)
+	case Base64RawStd:
+		return base64.RawStdEncoding.DecodeString(s)
+	case Base64RawURL:
+		return base64.RawURLEncoding.DecodeString(s)
+	case Hex:
+		return hex.DecodeString(s)
+	case Base32Std:
+		return base32.StdEncoding.DecodeString(s)
+	case AutoEncoding:
+		return decodeAuto(s)
+	}
+
+	return nil, fmt.Errorf("unknown encoding %d", int(e))
+}
+
+// GetEncodedBytes returns the bytes decoded with the encoding from environment variable or the default value,
+// a positive length is the exact number of decoded bytes expected, ex: 32 for an AES-256 key
+func GetEncodedBytes(key string, encoding Encoding, length int, defaultValue []byte) []byte {
+	return defaultReader.GetEncodedBytes(key, encoding, length, defaultValue)
+}
+
+// LookupEncodedBytes returns the bytes decoded with the encoding from environment variable or the default value,
+// with a *ParseError if the value is invalid or does not decode to length bytes
+func LookupEncodedBytes(key string, encoding Encoding, length int, defaultValue []byte) ([]byte, error) {
+	return defaultReader.LookupEncodedBytes(key, encoding, length, defaultValue)
+}
+
+// MustEncodedBytes returns the bytes decoded with the encoding from environment variable, it panics if the variable is not set or invalid
+func MustEncodedBytes(key string, encoding Encoding, length int) []byte {
+	return defaultReader.MustEncodedBytes(key, encoding, length)
+}
+
+// GetEncodedBytes returns the bytes decoded with the encoding from environment variable or the default value, see GetEncodedBytes
+func (r *Reader) GetEncodedBytes(key string, encoding Encoding, length int, defaultValue []byte) []byte {
+	value, _ := r.LookupEncodedBytes(key, encoding, length, defaultValue)
+	return value
+}
+
+// LookupEncodedBytes returns the bytes decoded with the encoding from environment variable or the default value, see LookupEncodedBytes
+func (r *Reader) LookupEncodedBytes(key string, encoding Encoding, length int, defaultValue []byte) ([]byte, error) {
+	return lookupWith(r, key, defaultValue, encoding.String(), func(s string) ([]byte, error) {
+		value, err := encoding.Decode(s)
+		if err != nil {
+			return nil, err
+		}
+		if length > 0 && len(value) != length {
+			return nil, fmt.Errorf("decoded to %d bytes, expected %d", len(value), length)
+		}
+		return value, nil
+	})
+}
+
+// MustEncodedBytes returns the bytes decoded with the encoding from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustEncodedBytes(key string, encoding Encoding, length int) []byte {
+	return must(r.required().LookupEncodedBytes(key, encoding, length, nil))
+}
+
+// decodeAuto decodes s as hex when it only has an even number of hexadecimal digits, otherwise as base64 trying the padded
+// and unpadded variants of both alphabets, a value accepted by more than one variant decodes to the same bytes in all of them
+func decodeAuto(s string) ([]byte, error) {
+	if len(s)%2 == 0 && isHex(s) {
+		return hex.DecodeString(s)
+	}
+
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding} {
+		if value, err := encoding.DecodeString(s); err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, errors.New("neither hex nor base64")
+}
+
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+
+	return true
+}
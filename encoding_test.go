@@ -0,0 +1,67 @@
+package env
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestReaderGetEncodedBytes(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"STD":     "+/+/",
+		"URL":     "-_-_",
+		"RAW_STD": "+/8",
+		"RAW_URL": "-_8",
+		"HEX":     "FBFF",
+		"BASE32":  "7P7Q====",
+		"INVALID": "!!",
+	})
+	expected := []byte{0xfb, 0xff}
+	defaultValue := []byte("default")
+
+	var tests = []struct {
+		kind          string
+		key           string
+		encoding      Encoding
+		length        int
+		expectedValue []byte
+	}{
+		{"test-std", "STD", Base64Std, 0, []byte{0xfb, 0xff, 0xbf}},
+		{"test-url", "URL", Base64URL, 0, []byte{0xfb, 0xff, 0xbf}},
+		{"test-raw-std", "RAW_STD", Base64RawStd, 0, expected},
+		{"test-raw-url", "RAW_URL", Base64RawURL, 0, expected},
+		{"test-hex", "HEX", Hex, 0, expected},
+		{"test-base32", "BASE32", Base32Std, 0, expected},
+		{"test-wrong-encoding", "URL", Base64Std, 0, defaultValue},
+		{"test-auto-hex", "HEX", AutoEncoding, 0, expected},
+		{"test-auto-url", "URL", AutoEncoding, 0, []byte{0xfb, 0xff, 0xbf}},
+		{"test-auto-raw-url", "RAW_URL", AutoEncoding, 0, expected},
+		{"test-auto-invalid", "INVALID", AutoEncoding, 0, defaultValue},
+		{"test-expected-length", "HEX", Hex, 2, expected},
+		{"test-unexpected-length", "HEX", Hex, 32, defaultValue},
+		{"test-default-value", "UNSET", Hex, 32, defaultValue},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			if result := r.GetEncodedBytes(tt.key, tt.encoding, tt.length, defaultValue); !bytes.Equal(result, tt.expectedValue) {
+				t.Errorf("r.GetEncodedBytes(\"%s\", %v, %d, %#v): expected %#v, actual %#v", tt.key, tt.encoding, tt.length, defaultValue, tt.expectedValue, result)
+			}
+		})
+	}
+
+	var parseErr *ParseError
+	if _, err := r.LookupEncodedBytes("HEX", Hex, 32, nil); !errors.As(err, &parseErr) || !strings.Contains(err.Error(), "decoded to 2 bytes, expected 32") {
+		t.Errorf("r.LookupEncodedBytes(\"HEX\", Hex, 32, nil): expected *ParseError, actual %v", err)
+	}
+	if result := Encoding(42).String(); result != "Encoding(42)" {
+		t.Errorf("Encoding(42).String(): expected %q, actual %q", "Encoding(42)", result)
+	}
+	expectPanic(t, `env: parsing "!!" from INVALID as base64 or hex: neither hex nor base64`, func() { r.MustEncodedBytes("INVALID", AutoEncoding, 0) })
+}
@@ -0,0 +1,174 @@
+package env
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// GetEnum returns a string value from environment variable when it is one of the allowed values or the default value
+func GetEnum(key string, allowed []string, defaultValue string) string {
+	return defaultReader.GetEnum(key, allowed, defaultValue)
+}
+
+// GetEnumFold returns the allowed value matching environment variable case-insensitively or the default value
+func GetEnumFold(key string, allowed []string, defaultValue string) string {
+	return defaultReader.GetEnumFold(key, allowed, defaultValue)
+}
+
+// LookupEnum returns a string value from environment variable when it is one of the allowed values or the default value,
+// with a *ParseError listing the allowed values if it is not
+func LookupEnum(key string, allowed []string, defaultValue string) (string, error) {
+	return defaultReader.LookupEnum(key, allowed, defaultValue)
+}
+
+// LookupEnumFold returns the allowed value matching environment variable case-insensitively or the default value,
+// with a *ParseError listing the allowed values if there is no match
+func LookupEnumFold(key string, allowed []string, defaultValue string) (string, error) {
+	return defaultReader.LookupEnumFold(key, allowed, defaultValue)
+}
+
+// MustEnum returns a string value from environment variable, it panics if the variable is not set or not one of the allowed values
+func MustEnum(key string, allowed []string) string {
+	return defaultReader.MustEnum(key, allowed)
+}
+
+// MustEnumFold returns the allowed value matching environment variable case-insensitively, it panics if the variable is not set or there is no match
+func MustEnumFold(key string, allowed []string) string {
+	return defaultReader.MustEnumFold(key, allowed)
+}
+
+// GetEnumOf returns the T value mapped to the name in environment variable or the default value, ex: map[string]slog.Level{"debug": slog.LevelDebug}
+func GetEnumOf[T any](key string, values map[string]T, defaultValue T) T {
+	return GetEnumOfFrom(defaultReader, key, values, defaultValue)
+}
+
+// GetEnumOfFold returns the T value mapped to the name matching environment variable case-insensitively or the default value
+func GetEnumOfFold[T any](key string, values map[string]T, defaultValue T) T {
+	return GetEnumOfFoldFrom(defaultReader, key, values, defaultValue)
+}
+
+// LookupEnumOf returns the T value mapped to the name in environment variable or the default value, with a *ParseError listing the names if it is unknown
+func LookupEnumOf[T any](key string, values map[string]T, defaultValue T) (T, error) {
+	return LookupEnumOfFrom(defaultReader, key, values, defaultValue)
+}
+
+// LookupEnumOfFold returns the T value mapped to the name matching environment variable case-insensitively or the default value,
+// with a *ParseError listing the names if there is no match
+func LookupEnumOfFold[T any](key string, values map[string]T, defaultValue T) (T, error) {
+	return LookupEnumOfFoldFrom(defaultReader, key, values, defaultValue)
+}
+
+// MustEnumOf returns the T value mapped to the name in environment variable, it panics if the variable is not set or the name is unknown
+func MustEnumOf[T any](key string, values map[string]T) T {
+	return MustEnumOfFrom(defaultReader, key, values)
+}
+
+// MustEnumOfFold returns the T value mapped to the name matching environment variable case-insensitively, it panics if the variable is not set or there is no match
+func MustEnumOfFold[T any](key string, values map[string]T) T {
+	return MustEnumOfFoldFrom(defaultReader, key, values)
+}
+
+// GetEnumOfFrom returns the T value mapped to the name in the reader's environment variable or the default value, see GetEnumOf
+func GetEnumOfFrom[T any](r *Reader, key string, values map[string]T, defaultValue T) T {
+	value, _ := LookupEnumOfFrom(r, key, values, defaultValue)
+	return value
+}
+
+// GetEnumOfFoldFrom returns the T value mapped to the name matching the reader's environment variable case-insensitively or the default value, see GetEnumOfFold
+func GetEnumOfFoldFrom[T any](r *Reader, key string, values map[string]T, defaultValue T) T {
+	value, _ := LookupEnumOfFoldFrom(r, key, values, defaultValue)
+	return value
+}
+
+// LookupEnumOfFrom returns the T value mapped to the name in the reader's environment variable or the default value, see LookupEnumOf
+func LookupEnumOfFrom[T any](r *Reader, key string, values map[string]T, defaultValue T) (T, error) {
+	return lookupEnum(r, key, sortedNames(values), values, false, defaultValue)
+}
+
+// LookupEnumOfFoldFrom returns the T value mapped to the name matching the reader's environment variable case-insensitively or the default value, see LookupEnumOfFold
+func LookupEnumOfFoldFrom[T any](r *Reader, key string, values map[string]T, defaultValue T) (T, error) {
+	return lookupEnum(r, key, sortedNames(values), values, true, defaultValue)
+}
+
+// MustEnumOfFrom returns the T value mapped to the name in the reader's environment variable, it panics if the variable is not set or the name is unknown
+func MustEnumOfFrom[T any](r *Reader, key string, values map[string]T) T {
+	var zero T
+	return must(LookupEnumOfFrom(r.required(), key, values, zero))
+}
+
+// MustEnumOfFoldFrom returns the T value mapped to the name matching the reader's environment variable case-insensitively, it panics if the variable is not set or there is no match
+func MustEnumOfFoldFrom[T any](r *Reader, key string, values map[string]T) T {
+	var zero T
+	return must(LookupEnumOfFoldFrom(r.required(), key, values, zero))
+}
+
+// GetEnum returns a string value from environment variable when it is one of the allowed values or the default value
+func (r *Reader) GetEnum(key string, allowed []string, defaultValue string) string {
+	value, _ := r.LookupEnum(key, allowed, defaultValue)
+	return value
+}
+
+// GetEnumFold returns the allowed value matching environment variable case-insensitively or the default value
+func (r *Reader) GetEnumFold(key string, allowed []string, defaultValue string) string {
+	value, _ := r.LookupEnumFold(key, allowed, defaultValue)
+	return value
+}
+
+// LookupEnum returns a string value from environment variable when it is one of the allowed values or the default value, see LookupEnum
+func (r *Reader) LookupEnum(key string, allowed []string, defaultValue string) (string, error) {
+	return lookupEnum(r, key, allowed, identityValues(allowed), false, defaultValue)
+}
+
+// LookupEnumFold returns the allowed value matching environment variable case-insensitively or the default value, see LookupEnumFold
+func (r *Reader) LookupEnumFold(key string, allowed []string, defaultValue string) (string, error) {
+	return lookupEnum(r, key, allowed, identityValues(allowed), true, defaultValue)
+}
+
+// MustEnum returns a string value from environment variable, it panics if the variable is not set or not one of the allowed values
+func (r *Reader) MustEnum(key string, allowed []string) string {
+	return must(r.required().LookupEnum(key, allowed, ""))
+}
+
+// MustEnumFold returns the allowed value matching environment variable case-insensitively, it panics if the variable is not set or there is no match
+func (r *Reader) MustEnumFold(key string, allowed []string) string {
+	return must(r.required().LookupEnumFold(key, allowed, ""))
+}
+
+// lookupEnum returns the value mapped to the name in environment variable, an exact match takes precedence over a
+// case-insensitive one when fold is set. The error lists the names in order.
+func lookupEnum[T any](r *Reader, key string, names []string, values map[string]T, fold bool, defaultValue T) (T, error) {
+	return lookupWith(r, key, defaultValue, "enum", func(s string) (T, error) {
+		if value, ok := values[s]; ok {
+			return value, nil
+		}
+		if fold {
+			for _, name := range names {
+				if strings.EqualFold(s, name) {
+					return values[name], nil
+				}
+			}
+		}
+
+		return defaultValue, fmt.Errorf("must be one of %s", strings.Join(names, ", "))
+	})
+}
+
+func identityValues(names []string) map[string]string {
+	values := make(map[string]string, len(names))
+	for _, name := range names {
+		values[name] = name
+	}
+
+	return values
+}
+
+func sortedNames[T any](values map[string]T) []string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
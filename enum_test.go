@@ -0,0 +1,89 @@
+package env
+
+import (
+	"errors"
+	"testing"
+)
+
+type testLevel int
+
+const (
+	testLevelDebug testLevel = iota
+	testLevelInfo
+	testLevelError
+)
+
+func TestReaderGetEnum(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"LOG_LEVEL":   "info",
+		"ENVIRONMENT": "Production",
+		"INVALID":     "verbose",
+	})
+	allowed := []string{"debug", "info", "error"}
+
+	var tests = []struct {
+		kind          string
+		key           string
+		fold          bool
+		expectedValue string
+	}{
+		{"test-allowed-value", "LOG_LEVEL", false, "info"},
+		{"test-case-mismatch", "ENVIRONMENT", false, "debug"},
+		{"test-invalid-value", "INVALID", false, "debug"},
+		{"test-default-value", "UNSET", false, "debug"},
+		{"test-fold-allowed-value", "LOG_LEVEL", true, "info"},
+		{"test-fold-invalid-value", "INVALID", true, "debug"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			result := r.GetEnum(tt.key, allowed, "debug")
+			if tt.fold {
+				result = r.GetEnumFold(tt.key, allowed, "debug")
+			}
+			if result != tt.expectedValue {
+				t.Errorf("r.GetEnum(\"%s\", %v, \"debug\") with fold %v: expected %q, actual %q", tt.key, allowed, tt.fold, tt.expectedValue, result)
+			}
+		})
+	}
+
+	environments := []string{"development", "staging", "production"}
+	if result := r.GetEnumFold("ENVIRONMENT", environments, "development"); result != "production" {
+		t.Errorf("r.GetEnumFold(\"ENVIRONMENT\", %v, \"development\"): expected %q, actual %q", environments, "production", result)
+	}
+
+	var parseErr *ParseError
+	_, err := r.LookupEnum("INVALID", allowed, "debug")
+	if !errors.As(err, &parseErr) || parseErr.Err.Error() != "must be one of debug, info, error" {
+		t.Errorf("r.LookupEnum(\"INVALID\", %v, \"debug\"): expected *ParseError listing the allowed values, actual %v", allowed, err)
+	}
+	expectPanic(t, `env: parsing "verbose" from INVALID as enum: must be one of debug, info, error`, func() { r.MustEnumFold("INVALID", allowed) })
+}
+
+func TestReaderGetEnumOf(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"LOG_LEVEL": "ERROR",
+		"INVALID":   "verbose",
+	})
+	levels := map[string]testLevel{"debug": testLevelDebug, "info": testLevelInfo, "error": testLevelError}
+
+	if result := GetEnumOfFrom(r, "LOG_LEVEL", levels, testLevelInfo); result != testLevelInfo {
+		t.Errorf("GetEnumOfFrom(r, \"LOG_LEVEL\", levels, testLevelInfo): expected %v, actual %v", testLevelInfo, result)
+	}
+	if result := GetEnumOfFoldFrom(r, "LOG_LEVEL", levels, testLevelInfo); result != testLevelError {
+		t.Errorf("GetEnumOfFoldFrom(r, \"LOG_LEVEL\", levels, testLevelInfo): expected %v, actual %v", testLevelError, result)
+	}
+
+	_, err := LookupEnumOfFoldFrom(r, "INVALID", levels, testLevelInfo)
+	if err == nil || err.Error() != `env: parsing "verbose" from INVALID as enum: must be one of debug, error, info` {
+		t.Errorf("LookupEnumOfFoldFrom(r, \"INVALID\", levels, testLevelInfo): unexpected error %v", err)
+	}
+	expectPanic(t, "env: UNSET is not set", func() { MustEnumOfFrom(r, "UNSET", levels) })
+}
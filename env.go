@@ -1,520 +1,363 @@
 package env
 
 import (
-	b64 "encoding/base64"
-	"os"
-	"strconv"
-	"strings"
 	"time"
 )
 
+// Get returns a T value from environment variable or the default value
+func Get[T Value](key string, defaultValue T) T {
+	return GetFrom(defaultReader, key, defaultValue)
+}
+
+// GetSlice returns a T slice from environment variable or the default value
+func GetSlice[T Value](key, sep string, defaultValue []T) []T {
+	return GetSliceFrom(defaultReader, key, sep, defaultValue)
+}
+
 // GetString returns a string value from environment variable or the default value
 func GetString(key, defaultValue string) string {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	return val
+	return defaultReader.GetString(key, defaultValue)
 }
 
 // GetStringSlice returns a string slice from environment variable or the default value
 func GetStringSlice(key, sep string, defaultValue []string) []string {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	var slice []string
-	slice = append(slice, strings.Split(val, sep)...)
-
-	return slice
+	return defaultReader.GetStringSlice(key, sep, defaultValue)
 }
 
 // GetInt returns a int value from environment variable or the default value
 func GetInt(key string, defaultValue int) int {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	result, err := strconv.Atoi(val)
-	if err != nil {
-		return defaultValue
-	}
-
-	return result
+	return defaultReader.GetInt(key, defaultValue)
 }
 
 // GetIntSlice returns a int slice from environment variable or the default value
 func GetIntSlice(key, sep string, defaultValue []int) []int {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	var slice []int
-	for _, s := range strings.Split(val, sep) {
-		result, err := strconv.Atoi(s)
-		if err != nil {
-			return defaultValue
-		}
-		slice = append(slice, result)
-	}
-
-	return slice
+	return defaultReader.GetIntSlice(key, sep, defaultValue)
 }
 
 // GetInt8 returns a int8 value from environment variable or the default value
 func GetInt8(key string, defaultValue int8) int8 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	result, err := strconv.ParseInt(val, 10, 8)
-	if err != nil {
-		return defaultValue
-	}
-
-	return int8(result)
+	return defaultReader.GetInt8(key, defaultValue)
 }
 
 // GetInt8Slice returns a int8 slice from environment variable or the default value
 func GetInt8Slice(key, sep string, defaultValue []int8) []int8 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	var slice []int8
-	for _, s := range strings.Split(val, sep) {
-		result, err := strconv.ParseInt(s, 10, 8)
-		if err != nil {
-			return defaultValue
-		}
-		slice = append(slice, int8(result))
-	}
-
-	return slice
+	return defaultReader.GetInt8Slice(key, sep, defaultValue)
 }
 
 // GetInt16 returns a int16 value from environment variable or the default value
 func GetInt16(key string, defaultValue int16) int16 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	result, err := strconv.ParseInt(val, 10, 16)
-	if err != nil {
-		return defaultValue
-	}
-
-	return int16(result)
+	return defaultReader.GetInt16(key, defaultValue)
 }
 
 // GetInt16Slice returns a int8 slice from environment variable or the default value
 func GetInt16Slice(key, sep string, defaultValue []int16) []int16 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	var slice []int16
-	for _, s := range strings.Split(val, sep) {
-		result, err := strconv.ParseInt(s, 10, 16)
-		if err != nil {
-			return defaultValue
-		}
-		slice = append(slice, int16(result))
-	}
-
-	return slice
+	return defaultReader.GetInt16Slice(key, sep, defaultValue)
 }
 
 // GetInt32 returns a int32 value from environment variable or the default value
 func GetInt32(key string, defaultValue int32) int32 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	result, err := strconv.ParseInt(val, 10, 32)
-	if err != nil {
-		return defaultValue
-	}
-
-	return int32(result)
+	return defaultReader.GetInt32(key, defaultValue)
 }
 
 // GetInt32Slice returns a int32 slice from environment variable or the default value
 func GetInt32Slice(key, sep string, defaultValue []int32) []int32 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	var slice []int32
-	for _, s := range strings.Split(val, sep) {
-		result, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return defaultValue
-		}
-		slice = append(slice, int32(result))
-	}
-
-	return slice
+	return defaultReader.GetInt32Slice(key, sep, defaultValue)
 }
 
 // GetInt64 returns a int64 value from environment variable or the default value
 func GetInt64(key string, defaultValue int64) int64 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	result, err := strconv.ParseInt(val, 10, 64)
-	if err != nil {
-		return defaultValue
-	}
-
-	return result
+	return defaultReader.GetInt64(key, defaultValue)
 }
 
 // GetInt64Slice returns a int64 slice from environment variable or the default value
 func GetInt64Slice(key, sep string, defaultValue []int64) []int64 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	var slice []int64
-	for _, s := range strings.Split(val, sep) {
-		result, err := strconv.ParseInt(s, 10, 64)
-		if err != nil {
-			return defaultValue
-		}
-		slice = append(slice, result)
-	}
-
-	return slice
+	return defaultReader.GetInt64Slice(key, sep, defaultValue)
 }
 
 // GetUint returns a uint value from environment variable or the default value
 func GetUint(key string, defaultValue uint) uint {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	result, err := strconv.ParseUint(val, 10, 0)
-	if err != nil {
-		return defaultValue
-	}
-
-	return uint(result)
+	return defaultReader.GetUint(key, defaultValue)
 }
 
 // GetUintSlice returns a uint slice from environment variable or the default value
 func GetUintSlice(key, sep string, defaultValue []uint) []uint {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	var slice []uint
-	for _, s := range strings.Split(val, sep) {
-		result, err := strconv.ParseUint(s, 10, 0)
-		if err != nil {
-			return defaultValue
-		}
-		slice = append(slice, uint(result))
-	}
-
-	return slice
+	return defaultReader.GetUintSlice(key, sep, defaultValue)
 }
 
 // GetUint8 returns a uint8 value from environment variable or the default value
 func GetUint8(key string, defaultValue uint8) uint8 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	result, err := strconv.ParseUint(val, 10, 8)
-	if err != nil {
-		return defaultValue
-	}
-
-	return uint8(result)
+	return defaultReader.GetUint8(key, defaultValue)
 }
 
 // GetUint8Slice returns a uint8 slice from environment variable or the default value
 func GetUint8Slice(key, sep string, defaultValue []uint8) []uint8 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	var slice []uint8
-	for _, s := range strings.Split(val, sep) {
-		result, err := strconv.ParseUint(s, 10, 8)
-		if err != nil {
-			return defaultValue
-		}
-		slice = append(slice, uint8(result))
-	}
-
-	return slice
+	return defaultReader.GetUint8Slice(key, sep, defaultValue)
 }
 
 // GetUint16 returns a uint16 value from environment variable or the default value
 func GetUint16(key string, defaultValue uint16) uint16 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	result, err := strconv.ParseUint(val, 10, 16)
-	if err != nil {
-		return defaultValue
-	}
-
-	return uint16(result)
+	return defaultReader.GetUint16(key, defaultValue)
 }
 
 // GetUint16Slice returns a uint16 slice from environment variable or the default value
 func GetUint16Slice(key, sep string, defaultValue []uint16) []uint16 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	var slice []uint16
-	for _, s := range strings.Split(val, sep) {
-		result, err := strconv.ParseUint(s, 10, 16)
-		if err != nil {
-			return defaultValue
-		}
-		slice = append(slice, uint16(result))
-	}
-
-	return slice
+	return defaultReader.GetUint16Slice(key, sep, defaultValue)
 }
 
 // GetUint32 returns a uint32 value from environment variable or the default value
 func GetUint32(key string, defaultValue uint32) uint32 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	result, err := strconv.ParseUint(val, 10, 32)
-	if err != nil {
-		return defaultValue
-	}
-
-	return uint32(result)
+	return defaultReader.GetUint32(key, defaultValue)
 }
 
 // GetUint32Slice returns a uint32 slice from environment variable or the default value
 func GetUint32Slice(key, sep string, defaultValue []uint32) []uint32 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	var slice []uint32
-	for _, s := range strings.Split(val, sep) {
-		result, err := strconv.ParseUint(s, 10, 32)
-		if err != nil {
-			return defaultValue
-		}
-		slice = append(slice, uint32(result))
-	}
-
-	return slice
+	return defaultReader.GetUint32Slice(key, sep, defaultValue)
 }
 
 // GetUint64 returns a uint64 value from environment variable or the default value
 func GetUint64(key string, defaultValue uint64) uint64 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	result, err := strconv.ParseUint(val, 10, 64)
-	if err != nil {
-		return defaultValue
-	}
-
-	return result
+	return defaultReader.GetUint64(key, defaultValue)
 }
 
 // GetUint64Slice returns a uint64 slice from environment variable or the default value
 func GetUint64Slice(key, sep string, defaultValue []uint64) []uint64 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	var slice []uint64
-	for _, s := range strings.Split(val, sep) {
-		result, err := strconv.ParseUint(s, 10, 64)
-		if err != nil {
-			return defaultValue
-		}
-		slice = append(slice, result)
-	}
-
-	return slice
+	return defaultReader.GetUint64Slice(key, sep, defaultValue)
 }
 
 // GetBool returns a boolean value from environment variable or the default value
 func GetBool(key string, defaultValue bool) bool {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	result, err := strconv.ParseBool(val)
-	if err != nil {
-		return defaultValue
-	}
-
-	return result
+	return defaultReader.GetBool(key, defaultValue)
 }
 
 // GetBoolSlice returns a boolean slice from environment variable or the default value
 func GetBoolSlice(key, sep string, defaultValue []bool) []bool {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	var slice []bool
-	for _, s := range strings.Split(val, sep) {
-		result, err := strconv.ParseBool(s)
-		if err != nil {
-			return defaultValue
-		}
-		slice = append(slice, result)
-	}
-
-	return slice
+	return defaultReader.GetBoolSlice(key, sep, defaultValue)
 }
 
 // GetFloat32 returns a float32 value from environment variable or the default value
 func GetFloat32(key string, defaultValue float32) float32 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	result, err := strconv.ParseFloat(val, 32)
-	if err != nil {
-		return defaultValue
-	}
-
-	return float32(result)
+	return defaultReader.GetFloat32(key, defaultValue)
 }
 
 // GetFloat32Slice returns a float32 slice from environment variable or the default value
 func GetFloat32Slice(key, sep string, defaultValue []float32) []float32 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	var slice []float32
-	for _, s := range strings.Split(val, sep) {
-		result, err := strconv.ParseFloat(s, 32)
-		if err != nil {
-			return defaultValue
-		}
-		slice = append(slice, float32(result))
-	}
-
-	return slice
+	return defaultReader.GetFloat32Slice(key, sep, defaultValue)
 }
 
 // GetFloat64 returns a float64 value from environment variable or the default value
 func GetFloat64(key string, defaultValue float64) float64 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	result, err := strconv.ParseFloat(val, 64)
-	if err != nil {
-		return defaultValue
-	}
-
-	return result
+	return defaultReader.GetFloat64(key, defaultValue)
 }
 
 // GetFloat64Slice returns a float64 slice from environment variable or the default value
 func GetFloat64Slice(key, sep string, defaultValue []float64) []float64 {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	var slice []float64
-	for _, s := range strings.Split(val, sep) {
-		result, err := strconv.ParseFloat(s, 64)
-		if err != nil {
-			return defaultValue
-		}
-		slice = append(slice, result)
-	}
-
-	return slice
+	return defaultReader.GetFloat64Slice(key, sep, defaultValue)
 }
 
 // GetBytes returns a byte slice value from environment variable or the default value
 func GetBytes(key string, defaultValue []byte) []byte {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	return []byte(val)
+	return defaultReader.GetBytes(key, defaultValue)
 }
 
-// GetDuration returns a time.Duration value from environment variable or the default value
+// GetDuration returns a time.Duration value from environment variable or the default value.
+// Plain integers are multiplied by duration, other values are parsed with ParseDuration, ex: 1m30s or 2d.
 func GetDuration(key string, defaultValue int64, duration time.Duration) time.Duration {
-	value := GetInt64(key, defaultValue)
-	return time.Duration(value) * duration
+	return defaultReader.GetDuration(key, defaultValue, duration)
+}
+
+// GetDurationSlice returns a time.Duration slice from environment variable or the default value.
+// Plain integers are multiplied by duration, other values are parsed with ParseDuration, ex: 250ms,1s,2s.
+func GetDurationSlice(key, sep string, defaultValue []time.Duration, duration time.Duration) []time.Duration {
+	return defaultReader.GetDurationSlice(key, sep, defaultValue, duration)
 }
 
 // GetBase64ToBytes converts a base64 string to a byte slice value from the environment variable or the default value
 func GetBase64ToBytes(key string, defaultValue []byte) []byte {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
-
-	result, err := b64.StdEncoding.DecodeString(val)
-	if err != nil {
-		return defaultValue
-	}
-
-	return result
+	return defaultReader.GetBase64ToBytes(key, defaultValue)
 }
 
 // GetBase64ToString converts a base64 string to a string value from the environment variable or the default value
 func GetBase64ToString(key string, defaultValue string) string {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
-	}
+	return defaultReader.GetBase64ToString(key, defaultValue)
+}
+
+// GetFrom returns a T value from the reader's environment variable or the default value
+func GetFrom[T Value](r *Reader, key string, defaultValue T) T {
+	value, _ := LookupFrom(r, key, defaultValue)
+	return value
+}
+
+// GetSliceFrom returns a T slice from the reader's environment variable or the default value
+func GetSliceFrom[T Value](r *Reader, key, sep string, defaultValue []T) []T {
+	value, _ := LookupSliceFrom(r, key, sep, defaultValue)
+	return value
+}
+
+// GetString returns a string value from environment variable or the default value
+func (r *Reader) GetString(key, defaultValue string) string {
+	return GetFrom(r, key, defaultValue)
+}
 
-	result, err := b64.StdEncoding.DecodeString(val)
-	if err != nil {
-		return defaultValue
-	}
+// GetStringSlice returns a string slice from environment variable or the default value
+func (r *Reader) GetStringSlice(key, sep string, defaultValue []string) []string {
+	return GetSliceFrom(r, key, sep, defaultValue)
+}
+
+// GetInt returns a int value from environment variable or the default value
+func (r *Reader) GetInt(key string, defaultValue int) int {
+	return GetFrom(r, key, defaultValue)
+}
 
-	return string(result)
+// GetIntSlice returns a int slice from environment variable or the default value
+func (r *Reader) GetIntSlice(key, sep string, defaultValue []int) []int {
+	return GetSliceFrom(r, key, sep, defaultValue)
+}
+
+// GetInt8 returns a int8 value from environment variable or the default value
+func (r *Reader) GetInt8(key string, defaultValue int8) int8 {
+	return GetFrom(r, key, defaultValue)
+}
+
+// GetInt8Slice returns a int8 slice from environment variable or the default value
+func (r *Reader) GetInt8Slice(key, sep string, defaultValue []int8) []int8 {
+	return GetSliceFrom(r, key, sep, defaultValue)
+}
+
+// GetInt16 returns a int16 value from environment variable or the default value
+func (r *Reader) GetInt16(key string, defaultValue int16) int16 {
+	return GetFrom(r, key, defaultValue)
+}
+
+// GetInt16Slice returns a int8 slice from environment variable or the default value
+func (r *Reader) GetInt16Slice(key, sep string, defaultValue []int16) []int16 {
+	return GetSliceFrom(r, key, sep, defaultValue)
+}
+
+// GetInt32 returns a int32 value from environment variable or the default value
+func (r *Reader) GetInt32(key string, defaultValue int32) int32 {
+	return GetFrom(r, key, defaultValue)
+}
+
+// GetInt32Slice returns a int32 slice from environment variable or the default value
+func (r *Reader) GetInt32Slice(key, sep string, defaultValue []int32) []int32 {
+	return GetSliceFrom(r, key, sep, defaultValue)
+}
+
+// GetInt64 returns a int64 value from environment variable or the default value
+func (r *Reader) GetInt64(key string, defaultValue int64) int64 {
+	return GetFrom(r, key, defaultValue)
+}
+
+// GetInt64Slice returns a int64 slice from environment variable or the default value
+func (r *Reader) GetInt64Slice(key, sep string, defaultValue []int64) []int64 {
+	return GetSliceFrom(r, key, sep, defaultValue)
+}
+
+// GetUint returns a uint value from environment variable or the default value
+func (r *Reader) GetUint(key string, defaultValue uint) uint {
+	return GetFrom(r, key, defaultValue)
+}
+
+// GetUintSlice returns a uint slice from environment variable or the default value
+func (r *Reader) GetUintSlice(key, sep string, defaultValue []uint) []uint {
+	return GetSliceFrom(r, key, sep, defaultValue)
+}
+
+// GetUint8 returns a uint8 value from environment variable or the default value
+func (r *Reader) GetUint8(key string, defaultValue uint8) uint8 {
+	return GetFrom(r, key, defaultValue)
+}
+
+// GetUint8Slice returns a uint8 slice from environment variable or the default value
+func (r *Reader) GetUint8Slice(key, sep string, defaultValue []uint8) []uint8 {
+	return GetSliceFrom(r, key, sep, defaultValue)
+}
+
+// GetUint16 returns a uint16 value from environment variable or the default value
+func (r *Reader) GetUint16(key string, defaultValue uint16) uint16 {
+	return GetFrom(r, key, defaultValue)
+}
+
+// GetUint16Slice returns a uint16 slice from environment variable or the default value
+func (r *Reader) GetUint16Slice(key, sep string, defaultValue []uint16) []uint16 {
+	return GetSliceFrom(r, key, sep, defaultValue)
+}
+
+// GetUint32 returns a uint32 value from environment variable or the default value
+func (r *Reader) GetUint32(key string, defaultValue uint32) uint32 {
+	return GetFrom(r, key, defaultValue)
+}
+
+// GetUint32Slice returns a uint32 slice from environment variable or the default value
+func (r *Reader) GetUint32Slice(key, sep string, defaultValue []uint32) []uint32 {
+	return GetSliceFrom(r, key, sep, defaultValue)
+}
+
+// GetUint64 returns a uint64 value from environment variable or the default value
+func (r *Reader) GetUint64(key string, defaultValue uint64) uint64 {
+	return GetFrom(r, key, defaultValue)
+}
+
+// GetUint64Slice returns a uint64 slice from environment variable or the default value
+func (r *Reader) GetUint64Slice(key, sep string, defaultValue []uint64) []uint64 {
+	return GetSliceFrom(r, key, sep, defaultValue)
+}
+
+// GetBool returns a boolean value from environment variable or the default value
+func (r *Reader) GetBool(key string, defaultValue bool) bool {
+	return GetFrom(r, key, defaultValue)
+}
+
+// GetBoolSlice returns a boolean slice from environment variable or the default value
+func (r *Reader) GetBoolSlice(key, sep string, defaultValue []bool) []bool {
+	return GetSliceFrom(r, key, sep, defaultValue)
+}
+
+// GetFloat32 returns a float32 value from environment variable or the default value
+func (r *Reader) GetFloat32(key string, defaultValue float32) float32 {
+	return GetFrom(r, key, defaultValue)
+}
+
+// GetFloat32Slice returns a float32 slice from environment variable or the default value
+func (r *Reader) GetFloat32Slice(key, sep string, defaultValue []float32) []float32 {
+	return GetSliceFrom(r, key, sep, defaultValue)
+}
+
+// GetFloat64 returns a float64 value from environment variable or the default value
+func (r *Reader) GetFloat64(key string, defaultValue float64) float64 {
+	return GetFrom(r, key, defaultValue)
+}
+
+// GetFloat64Slice returns a float64 slice from environment variable or the default value
+func (r *Reader) GetFloat64Slice(key, sep string, defaultValue []float64) []float64 {
+	return GetSliceFrom(r, key, sep, defaultValue)
+}
+
+// GetBytes returns a byte slice value from environment variable or the default value
+func (r *Reader) GetBytes(key string, defaultValue []byte) []byte {
+	return GetFrom(r, key, defaultValue)
+}
+
+// GetDuration returns a time.Duration value from environment variable or the default value, see GetDuration
+func (r *Reader) GetDuration(key string, defaultValue int64, duration time.Duration) time.Duration {
+	value, _ := r.LookupDuration(key, defaultValue, duration)
+	return value
+}
+
+// GetDurationSlice returns a time.Duration slice from environment variable or the default value, see GetDurationSlice
+func (r *Reader) GetDurationSlice(key, sep string, defaultValue []time.Duration, duration time.Duration) []time.Duration {
+	value, _ := r.LookupDurationSlice(key, sep, defaultValue, duration)
+	return value
+}
+
+// GetBase64ToBytes converts a base64 string to a byte slice value from the environment variable or the default value
+func (r *Reader) GetBase64ToBytes(key string, defaultValue []byte) []byte {
+	value, _ := r.LookupBase64ToBytes(key, defaultValue)
+	return value
+}
+
+// GetBase64ToString converts a base64 string to a string value from the environment variable or the default value
+func (r *Reader) GetBase64ToString(key string, defaultValue string) string {
+	value, _ := r.LookupBase64ToString(key, defaultValue)
+	return value
 }
@@ -842,3 +842,50 @@ func TestGetBase64ToString(t *testing.T) {
 		})
 	}
 }
+
+func TestGet(t *testing.T) {
+	os.Setenv("GENERIC_INT8", "8")          //nolint:errcheck
+	os.Setenv("GENERIC_UINT32", "32")       //nolint:errcheck
+	os.Setenv("GENERIC_FLOAT32", "1.5")     //nolint:errcheck
+	os.Setenv("GENERIC_DURATION", "1m30s")  //nolint:errcheck
+	os.Setenv("GENERIC_BYTES", "bytes")     //nolint:errcheck
+	os.Setenv("GENERIC_INVALID", "invalid") //nolint:errcheck
+
+	if result := Get("GENERIC_INT8", int8(1)); result != 8 {
+		t.Errorf("Get(\"GENERIC_INT8\", 1): expected %d, actual %d", 8, result)
+	}
+	if result := Get("GENERIC_UINT32", uint32(1)); result != 32 {
+		t.Errorf("Get(\"GENERIC_UINT32\", 1): expected %d, actual %d", 32, result)
+	}
+	if result := Get("GENERIC_FLOAT32", float32(1)); result != 1.5 {
+		t.Errorf("Get(\"GENERIC_FLOAT32\", 1): expected %v, actual %v", 1.5, result)
+	}
+	if result := Get("GENERIC_DURATION", time.Second); result != 90*time.Second {
+		t.Errorf("Get(\"GENERIC_DURATION\", 1s): expected %v, actual %v", 90*time.Second, result)
+	}
+	if result := Get("GENERIC_BYTES", []byte("default")); !bytes.Equal(result, []byte("bytes")) {
+		t.Errorf("Get(\"GENERIC_BYTES\", \"default\"): expected %q, actual %q", "bytes", result)
+	}
+	if result := Get("GENERIC_INVALID", time.Second); result != time.Second {
+		t.Errorf("Get(\"GENERIC_INVALID\", 1s): expected %v, actual %v", time.Second, result)
+	}
+	if result := Get("GENERIC_UNSET", "default"); result != "default" {
+		t.Errorf("Get(\"GENERIC_UNSET\", \"default\"): expected %q, actual %q", "default", result)
+	}
+}
+
+func TestGetSlice(t *testing.T) {
+	os.Setenv("GENERIC_INT16_SLICE", "1,2,3")      //nolint:errcheck
+	os.Setenv("GENERIC_DURATION_SLICE", "1s,2m")   //nolint:errcheck
+	os.Setenv("GENERIC_INVALID_SLICE", "1,2,três") //nolint:errcheck
+
+	if result := GetSlice("GENERIC_INT16_SLICE", ",", []int16{1}); !reflect.DeepEqual(result, []int16{1, 2, 3}) {
+		t.Errorf("GetSlice(\"GENERIC_INT16_SLICE\", \",\", []int16{1}): expected %#v, actual %#v", []int16{1, 2, 3}, result)
+	}
+	if result := GetSlice("GENERIC_DURATION_SLICE", ",", []time.Duration{time.Second}); !reflect.DeepEqual(result, []time.Duration{time.Second, 2 * time.Minute}) {
+		t.Errorf("GetSlice(\"GENERIC_DURATION_SLICE\", \",\", []time.Duration{1s}): expected %v, actual %v", []time.Duration{time.Second, 2 * time.Minute}, result)
+	}
+	if result := GetSlice("GENERIC_INVALID_SLICE", ",", []uint{3}); !reflect.DeepEqual(result, []uint{3}) {
+		t.Errorf("GetSlice(\"GENERIC_INVALID_SLICE\", \",\", []uint{3}): expected %#v, actual %#v", []uint{3}, result)
+	}
+}
@@ -0,0 +1,82 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ParseError is returned by the Lookup functions when an environment variable is set but cannot be parsed
+type ParseError struct {
+	Key   string // environment variable name
+	Value string // raw value of the environment variable
+	Type  string // type the value was parsed as, ex: int8 or []float64
+	Err   error  // underlying error, usually a *strconv.NumError
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("env: parsing %q from %s as %s: %v", e.Value, e.Key, e.Type, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// NotSetError is reported when a required environment variable is not set
+type NotSetError struct {
+	Key string // environment variable name
+}
+
+func (e *NotSetError) Error() string {
+	return fmt.Sprintf("env: %s is not set", e.Key)
+}
+
+// EmptyError is reported when an environment variable is set to an empty value and the policy is EmptyReject
+type EmptyError struct {
+	Key string // environment variable name
+}
+
+func (e *EmptyError) Error() string {
+	return fmt.Sprintf("env: %s is empty", e.Key)
+}
+
+// Errors aggregates every error found while loading environment variables.
+// It follows the errors.Join semantics, the message has one line per error and errors.Is/errors.As inspect each error.
+type Errors []error
+
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns the aggregated errors
+func (e Errors) Unwrap() []error {
+	return e
+}
+
+// Is reports whether any of the aggregated errors matches target, used by errors.Is before Go 1.20
+func (e Errors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first aggregated error that matches target, used by errors.As before Go 1.20
+func (e Errors) As(target any) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
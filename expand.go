@@ -0,0 +1,166 @@
+package env
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ExpandError is returned when the value of an environment variable cannot be expanded
+type ExpandError struct {
+	Key string // environment variable being expanded
+	Msg string // description of the error
+}
+
+func (e *ExpandError) Error() string {
+	return fmt.Sprintf("env: expanding %s: %s", e.Key, e.Msg)
+}
+
+// WithExpansion returns a reader that expands references to other environment variables in the values:
+//
+//	$VAR or ${VAR}     value of VAR, empty when VAR is not set
+//	${VAR:-fallback}   value of VAR, fallback when VAR is not set or empty
+//	${VAR:?message}    value of VAR, an *ExpandError with the message when VAR is not set or empty
+//	$$                 literal $
+//
+// Referenced values are expanded recursively, reference cycles are reported with an *ExpandError. References are not
+// prefixed and are resolved like direct lookups, with the file fallback and the empty policy of the reader.
+func WithExpansion() *Reader {
+	return defaultReader.WithExpansion()
+}
+
+// WithExpansion returns a copy of the reader that expands references to other environment variables, see WithExpansion
+func (r *Reader) WithExpansion() *Reader {
+	c := *r
+	c.expand = true
+	return &c
+}
+
+// expandValue expands the references in value, stack holds the variables being expanded to detect cycles
+func (r *Reader) expandValue(value string, stack []string) (string, error) {
+	if !strings.Contains(value, "$") {
+		return value, nil
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '$' || i+1 == len(value) {
+			sb.WriteByte(value[i])
+			continue
+		}
+
+		switch next := value[i+1]; {
+		case next == '$':
+			sb.WriteByte('$')
+			i++
+		case next == '{':
+			end := matchingBrace(value, i+2)
+			if end < 0 {
+				return "", &ExpandError{Key: stack[0], Msg: fmt.Sprintf("unterminated reference %q", value[i:])}
+			}
+			result, err := r.expandBraces(value[i+2:end], stack)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(result)
+			i = end
+		case isNameStart(next):
+			end := i + 2
+			for end < len(value) && isNameChar(value[end]) {
+				end++
+			}
+			result, _, err := r.expandReference(value[i+1:end], stack)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(result)
+			i = end - 1
+		default:
+			sb.WriteByte('$')
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// expandBraces expands the content of a ${...} reference
+func (r *Reader) expandBraces(content string, stack []string) (string, error) {
+	name, operator, operand := content, "", ""
+	if idx := strings.IndexByte(content, ':'); idx >= 0 {
+		name, operator = content[:idx], content[idx:]
+		if len(operator) >= 2 {
+			operator, operand = operator[:2], operator[2:]
+		}
+	}
+	if !isName(name) || operator != "" && operator != ":-" && operator != ":?" {
+		return "", &ExpandError{Key: stack[0], Msg: fmt.Sprintf("invalid reference ${%s}", content)}
+	}
+
+	result, ok, err := r.expandReference(name, stack)
+	if err != nil || ok && result != "" {
+		return result, err
+	}
+
+	switch operator {
+	case ":-":
+		return r.expandValue(operand, stack)
+	case ":?":
+		if operand == "" {
+			operand = "not set or empty"
+		}
+		return "", &ExpandError{Key: stack[0], Msg: fmt.Sprintf("%s: %s", name, operand)}
+	}
+
+	return result, nil
+}
+
+// expandReference returns the value of the referenced variable and whether it is set, the name is not prefixed
+// and the value is resolved like a direct lookup, with the file fallback and the empty policy of the reader
+func (r *Reader) expandReference(name string, stack []string) (string, bool, error) {
+	for _, key := range stack {
+		if key == name {
+			return "", false, &ExpandError{Key: stack[0], Msg: fmt.Sprintf("reference cycle %s -> %s", strings.Join(stack, " -> "), name)}
+		}
+	}
+
+	return r.resolveName(name, stack)
+}
+
+// matchingBrace returns the index of the brace closing the reference that starts at start, or -1
+func matchingBrace(value string, start int) int {
+	depth := 1
+	for i := start; i < len(value); i++ {
+		switch value[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+func isName(s string) bool {
+	if s == "" || !isNameStart(s[0]) {
+		return false
+	}
+
+	for i := 1; i < len(s); i++ {
+		if !isNameChar(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z'
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || c >= '0' && c <= '9'
+}
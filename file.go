@@ -0,0 +1,90 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// DefaultFileLimit is the maximum size of the files read by WithFileFallback when no limit is given and by DirSource
+const DefaultFileLimit = 64 << 10
+
+// ErrFileTooLarge is wrapped by the *FileError returned when a file exceeds the size limit
+var ErrFileTooLarge = errors.New("file too large")
+
+// FileError is returned when the file pointed to by a KEY_FILE environment variable cannot be read
+type FileError struct {
+	Key  string // environment variable holding the path, ex: DB_PASSWORD_FILE
+	Path string // path of the file
+	Err  error  // underlying error
+}
+
+func (e *FileError) Error() string {
+	return fmt.Sprintf("env: reading %s from %s: %v", e.Path, e.Key, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *FileError) Unwrap() error {
+	return e.Err
+}
+
+// WithFileFallback returns a reader that follows the Docker and Kubernetes secrets convention: when KEY is not set and
+// KEY_FILE is, the value is the content of the file pointed to by KEY_FILE without the trailing newline.
+// Files larger than limit bytes are rejected, a limit <= 0 uses DefaultFileLimit.
+// Unreadable or too large files are reported with a *FileError.
+func WithFileFallback(limit int64) *Reader {
+	return defaultReader.WithFileFallback(limit)
+}
+
+// WithFileFallback returns a copy of the reader that reads KEY_FILE when KEY is not set, see WithFileFallback
+func (r *Reader) WithFileFallback(limit int64) *Reader {
+	if limit <= 0 {
+		limit = DefaultFileLimit
+	}
+
+	c := *r
+	c.fileLimit = limit
+	return &c
+}
+
+// lookupFile returns the content of the file pointed to by the name_FILE variable and whether it is set
+func (r *Reader) lookupFile(name string) (string, bool, error) {
+	fileKey := name + "_FILE"
+	path, ok, err := lookupSource(r.source, fileKey)
+	if err != nil || !ok {
+		return "", false, err
+	}
+
+	value, err := readFile(path, r.fileLimit)
+	if err != nil {
+		return "", false, &FileError{Key: fileKey, Path: path, Err: err}
+	}
+
+	return value, true, nil
+}
+
+// readFile returns the content of the file without the trailing newline, failing when it is larger than limit bytes
+func readFile(path string, limit int64) (string, error) {
+	f, err := os.Open(path) //nolint:gosec
+	if err != nil {
+		return "", err
+	}
+	defer f.Close() //nolint:errcheck
+
+	data, err := io.ReadAll(io.LimitReader(f, limit+1))
+	if err != nil {
+		return "", err
+	}
+	if int64(len(data)) > limit {
+		return "", fmt.Errorf("%w: larger than %d bytes", ErrFileTooLarge, limit)
+	}
+
+	return trimTrailingNewline(string(data)), nil
+}
+
+func trimTrailingNewline(value string) string {
+	value = strings.TrimSuffix(value, "\n")
+	return strings.TrimSuffix(value, "\r")
+}
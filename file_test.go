@@ -0,0 +1,89 @@
+package env
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWithFileFallback(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	password := filepath.Join(dir, "db_password")
+	if err := os.WriteFile(password, []byte("secret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	port := filepath.Join(dir, "port")
+	if err := os.WriteFile(port, []byte("5432"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	large := filepath.Join(dir, "large")
+	if err := os.WriteFile(large, []byte(strings.Repeat("x", 17)), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewMapReader(map[string]string{
+		"DB_PASSWORD_FILE": password,
+		"DB_PORT_FILE":     port,
+		"DB_USER":          "user",
+		"DB_USER_FILE":     password,
+		"MISSING_FILE":     filepath.Join(dir, "missing"),
+		"LARGE_FILE":       large,
+	}).WithFileFallback(16)
+
+	if result := r.GetString("DB_PASSWORD", ""); result != "secret" {
+		t.Errorf("r.GetString(\"DB_PASSWORD\", \"\"): expected %q, actual %q", "secret", result)
+	}
+	if result := r.GetInt("DB_PORT", 0); result != 5432 {
+		t.Errorf("r.GetInt(\"DB_PORT\", 0): expected %d, actual %d", 5432, result)
+	}
+	if result := r.GetString("DB_USER", ""); result != "user" {
+		t.Errorf("r.GetString(\"DB_USER\", \"\"): expected the variable to win over the file, actual %q", result)
+	}
+	if result := r.GetString("UNSET", "default"); result != "default" {
+		t.Errorf("r.GetString(\"UNSET\", \"default\"): expected %q, actual %q", "default", result)
+	}
+
+	var fileErr *FileError
+	if _, err := r.LookupString("MISSING", ""); !errors.As(err, &fileErr) || fileErr.Key != "MISSING_FILE" || !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("r.LookupString(\"MISSING\", \"\"): expected *FileError wrapping os.ErrNotExist, actual %v", err)
+	}
+	if _, err := r.LookupString("LARGE", ""); !errors.Is(err, ErrFileTooLarge) {
+		t.Errorf("r.LookupString(\"LARGE\", \"\"): expected ErrFileTooLarge, actual %v", err)
+	}
+	expectPanic(t, "env: reading "+large+" from LARGE_FILE: file too large: larger than 16 bytes", func() { r.MustString("LARGE") })
+
+	if result := NewMapReader(map[string]string{"DB_PASSWORD_FILE": password}).GetString("DB_PASSWORD", "default"); result != "default" {
+		t.Errorf("GetString(\"DB_PASSWORD\", \"default\"): expected the fallback to be opt-in, actual %q", result)
+	}
+	if result := NewMapReader(map[string]string{"LARGE_FILE": large}).WithFileFallback(0).GetString("LARGE", ""); len(result) != 17 {
+		t.Errorf("WithFileFallback(0).GetString(\"LARGE\", \"\"): expected DefaultFileLimit to be used, actual %q", result)
+	}
+}
+
+func TestMustWithFileFallbackReadsOnce(t *testing.T) {
+	t.Parallel()
+
+	password := filepath.Join(t.TempDir(), "db_password")
+	if err := os.WriteFile(password, []byte("secret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var lookups []string
+	r := NewReader(SourceFunc(func(key string) (string, bool) {
+		lookups = append(lookups, key)
+		return MapSource{"DB_PASSWORD_FILE": password}.Lookup(key)
+	})).WithFileFallback(0)
+
+	if result := r.MustString("DB_PASSWORD"); result != "secret" {
+		t.Errorf("r.MustString(\"DB_PASSWORD\"): expected %q, actual %q", "secret", result)
+	}
+	if expected := []string{"DB_PASSWORD", "DB_PASSWORD_FILE"}; !reflect.DeepEqual(lookups, expected) {
+		t.Errorf("r.MustString(\"DB_PASSWORD\"): expected lookups %v, actual %v", expected, lookups)
+	}
+	expectPanic(t, "env: DB_USER is not set", func() { r.MustString("DB_USER") })
+}
@@ -0,0 +1,105 @@
+package env
+
+import (
+	"strconv"
+	"strings"
+)
+
+// IntOptions configures how the integer functions parse values, the zero value only accepts base 10 digits
+type IntOptions struct {
+	Literals    bool // Go integer literal syntax: 0x1F, 0o755, 0b1010 and 1_000_000, a leading 0 alone also means octal
+	Multipliers bool // decimal k, K, M, G, T, P and E suffixes, 10k is 10000, hexadecimal literals have no suffix
+}
+
+// intMultipliers maps the suffixes accepted with IntOptions.Multipliers to their values
+var intMultipliers = map[byte]uint64{'k': 1e3, 'K': 1e3, 'M': 1e6, 'G': 1e9, 'T': 1e12, 'P': 1e15, 'E': 1e18}
+
+// WithIntOptions returns a reader that parses the values of every integer function with the options
+func WithIntOptions(options IntOptions) *Reader {
+	return defaultReader.WithIntOptions(options)
+}
+
+// WithIntOptions returns a copy of the reader that parses the values of every integer function with the options
+func (r *Reader) WithIntOptions(options IntOptions) *Reader {
+	c := *r
+	c.intOptions = options
+	return &c
+}
+
+// cut returns the number part of s with the base and the multiplier it must be parsed with
+func (o IntOptions) cut(s string) (string, int, uint64) {
+	base := 10
+	if o.Literals {
+		base = 0
+	}
+	if !o.Multipliers || s == "" {
+		return s, base, 1
+	}
+
+	unsigned := strings.TrimLeft(s, "+-")
+	if o.Literals && (strings.HasPrefix(unsigned, "0x") || strings.HasPrefix(unsigned, "0X")) {
+		return s, base, 1
+	}
+	if multiplier, ok := intMultipliers[s[len(s)-1]]; ok {
+		return s[:len(s)-1], base, multiplier
+	}
+
+	return s, base, 1
+}
+
+// parseInt is strconv.ParseInt honoring the options, values out of range are clamped to the bounds of bitSize with the error
+func (o IntOptions) parseInt(s string, bitSize int) (int64, error) {
+	number, base, multiplier := o.cut(s)
+	n, err := strconv.ParseInt(number, base, bitSize)
+	if err != nil {
+		return n, numError("ParseInt", s, err)
+	}
+	if multiplier == 1 {
+		return n, nil
+	}
+
+	if bitSize == 0 {
+		bitSize = strconv.IntSize
+	}
+	m := int64(multiplier)
+	maxValue, minValue := int64(1)<<(bitSize-1)-1, int64(-1)<<(bitSize-1)
+	if n > maxValue/m {
+		return maxValue, numError("ParseInt", s, strconv.ErrRange)
+	}
+	if n < minValue/m {
+		return minValue, numError("ParseInt", s, strconv.ErrRange)
+	}
+
+	return n * m, nil
+}
+
+// parseUint is strconv.ParseUint honoring the options, values out of range are clamped to the bounds of bitSize with the error
+func (o IntOptions) parseUint(s string, bitSize int) (uint64, error) {
+	number, base, multiplier := o.cut(s)
+	n, err := strconv.ParseUint(number, base, bitSize)
+	if err != nil {
+		return n, numError("ParseUint", s, err)
+	}
+	if multiplier == 1 {
+		return n, nil
+	}
+
+	if bitSize == 0 {
+		bitSize = strconv.IntSize
+	}
+	maxValue := uint64(1)<<bitSize - 1
+	if n > maxValue/multiplier {
+		return maxValue, numError("ParseUint", s, strconv.ErrRange)
+	}
+
+	return n * multiplier, nil
+}
+
+// numError reports the whole value s instead of the number part parsed by strconv
+func numError(fn, s string, err error) error {
+	if numErr, ok := err.(*strconv.NumError); ok {
+		err = numErr.Err
+	}
+
+	return &strconv.NumError{Func: fn, Num: s, Err: err}
+}
@@ -0,0 +1,144 @@
+package env
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestIntOptionsParseInt(t *testing.T) {
+	t.Parallel()
+
+	literals := IntOptions{Literals: true}
+	multipliers := IntOptions{Multipliers: true}
+	all := IntOptions{Literals: true, Multipliers: true}
+
+	var tests = []struct {
+		kind          string
+		options       IntOptions
+		value         string
+		bitSize       int
+		expectedValue int64
+		expectedError bool
+	}{
+		{"test-zero-options", IntOptions{}, "1_000", 64, 0, true},
+		{"test-hex", literals, "0x1F", 64, 31, false},
+		{"test-octal", literals, "0o755", 64, 493, false},
+		{"test-leading-zero-octal", literals, "0755", 64, 493, false},
+		{"test-binary", literals, "-0b101", 64, -5, false},
+		{"test-underscores", literals, "1_000_000", 64, 1000000, false},
+		{"test-multiplier-disabled", literals, "10k", 64, 0, true},
+		{"test-multiplier", multipliers, "10k", 64, 10000, false},
+		{"test-negative-multiplier", multipliers, "-2G", 64, -2000000000, false},
+		{"test-multiplier-without-literals", multipliers, "0x10", 64, 0, true},
+		{"test-literal-with-multiplier", all, "1_5M", 64, 15000000, false},
+		{"test-hex-without-multiplier", all, "0x1E", 64, 30, false},
+		{"test-multiplier-only", all, "k", 64, 0, true},
+		{"test-multiplier-range", multipliers, "128k", 16, 32767, true},
+		{"test-multiplier-min", multipliers, "-9E", 64, -9e18, false},
+		{"test-multiplier-overflow", multipliers, "10E", 64, 9223372036854775807, true},
+		{"test-multiplier-underflow", multipliers, "-10E", 64, -9223372036854775808, true},
+		{"test-literal-range", literals, "0x80", 8, 127, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := tt.options.parseInt(tt.value, tt.bitSize)
+			if result != tt.expectedValue {
+				t.Errorf("parseInt(%q, %d) with %+v: expected %d, actual %d", tt.value, tt.bitSize, tt.options, tt.expectedValue, result)
+			}
+			if (err != nil) != tt.expectedError {
+				t.Errorf("parseInt(%q, %d) with %+v: expected error %v, actual %v", tt.value, tt.bitSize, tt.options, tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestIntOptionsParseUint(t *testing.T) {
+	t.Parallel()
+
+	all := IntOptions{Literals: true, Multipliers: true}
+
+	var tests = []struct {
+		kind          string
+		value         string
+		bitSize       int
+		expectedValue uint64
+		expectedError bool
+	}{
+		{"test-hex", "0xFF", 8, 255, false},
+		{"test-multiplier", "18E", 64, 18e18, false},
+		{"test-multiplier-overflow", "19E", 64, 18446744073709551615, true},
+		{"test-multiplier-range", "256k", 16, 65535, true},
+		{"test-negative", "-1k", 64, 0, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := all.parseUint(tt.value, tt.bitSize)
+			if result != tt.expectedValue {
+				t.Errorf("parseUint(%q, %d): expected %d, actual %d", tt.value, tt.bitSize, tt.expectedValue, result)
+			}
+			if (err != nil) != tt.expectedError {
+				t.Errorf("parseUint(%q, %d): expected error %v, actual %v", tt.value, tt.bitSize, tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestReaderWithIntOptions(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]string{
+		"FLAGS":   "0x1F",
+		"MODE":    "0o755",
+		"BATCH":   "1_000_000",
+		"LIMITS":  "10k,2M,0b11",
+		"WEIGHTS": "a=1k,b=0x10",
+		"RANGE":   "300",
+	}
+	r := NewMapReader(values).WithIntOptions(IntOptions{Literals: true, Multipliers: true})
+
+	if result := NewMapReader(values).GetInt("FLAGS", 1); result != 1 {
+		t.Errorf("GetInt(\"FLAGS\", 1) without options: expected %d, actual %d", 1, result)
+	}
+	if result := r.GetInt("FLAGS", 1); result != 31 {
+		t.Errorf("r.GetInt(\"FLAGS\", 1): expected %d, actual %d", 31, result)
+	}
+	if result := r.GetUint32("MODE", 0); result != 0o755 {
+		t.Errorf("r.GetUint32(\"MODE\", 0): expected %d, actual %d", 0o755, result)
+	}
+	if result := r.GetInt64("BATCH", 0); result != 1000000 {
+		t.Errorf("r.GetInt64(\"BATCH\", 0): expected %d, actual %d", 1000000, result)
+	}
+	if result := r.GetUint16Slice("LIMITS", ",", nil); result != nil {
+		t.Errorf("r.GetUint16Slice(\"LIMITS\", \",\", nil): expected nil, actual %v", result)
+	}
+	if result := r.GetIntSlice("LIMITS", ",", nil); !reflect.DeepEqual(result, []int{10000, 2000000, 3}) {
+		t.Errorf("r.GetIntSlice(\"LIMITS\", \",\", nil): expected %v, actual %v", []int{10000, 2000000, 3}, result)
+	}
+	if result := r.GetIntMap("WEIGHTS", ",", "=", nil); !reflect.DeepEqual(result, map[string]int{"a": 1000, "b": 16}) {
+		t.Errorf("r.GetIntMap(\"WEIGHTS\", \",\", \"=\", nil): unexpected result %v", result)
+	}
+
+	_, err := r.LookupInt8("RANGE", 0)
+	if !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("r.LookupInt8(\"RANGE\", 0): expected strconv.ErrRange, actual %v", err)
+	}
+
+	type config struct {
+		Flags  uint8   `env:"FLAGS"`
+		Limits []int64 `env:"LIMITS"`
+	}
+	var cfg config
+	if err := r.Load(&cfg); err != nil || cfg.Flags != 31 || !reflect.DeepEqual(cfg.Limits, []int64{10000, 2000000, 3}) {
+		t.Errorf("r.Load(&cfg): unexpected result %+v, %v", cfg, err)
+	}
+}
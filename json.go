@@ -0,0 +1,137 @@
+package env
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// GetJSON returns a T value decoded from the JSON in environment variable or the default value
+func GetJSON[T any](key string, defaultValue T) T {
+	return GetJSONFrom(defaultReader, key, defaultValue)
+}
+
+// LookupJSON returns a T value decoded from the JSON in environment variable or the default value, with a *ParseError if the value is invalid.
+// Unknown object fields and trailing data are rejected.
+func LookupJSON[T any](key string, defaultValue T) (T, error) {
+	return LookupJSONFrom(defaultReader, key, defaultValue)
+}
+
+// MustJSON returns a T value decoded from the JSON in environment variable, it panics if the variable is not set or invalid
+func MustJSON[T any](key string) T {
+	return MustJSONFrom[T](defaultReader, key)
+}
+
+// DecodeJSON decodes the JSON in environment variable into the value pointed to by v, which is left untouched if the variable is not set.
+// Unknown object fields and trailing data are rejected with a *ParseError.
+func DecodeJSON(key string, v any) error {
+	return defaultReader.DecodeJSON(key, v)
+}
+
+// GetJSONFrom returns a T value decoded from the JSON in the reader's environment variable or the default value, see GetJSON
+func GetJSONFrom[T any](r *Reader, key string, defaultValue T) T {
+	value, _ := LookupJSONFrom(r, key, defaultValue)
+	return value
+}
+
+// LookupJSONFrom returns a T value decoded from the JSON in the reader's environment variable or the default value, see LookupJSON
+func LookupJSONFrom[T any](r *Reader, key string, defaultValue T) (T, error) {
+	return lookupWith(r, key, defaultValue, typeName[T](), func(s string) (T, error) {
+		var value T
+		if err := decodeJSON(s, &value); err != nil {
+			return defaultValue, err
+		}
+		return value, nil
+	})
+}
+
+// MustJSONFrom returns a T value decoded from the JSON in the reader's environment variable, it panics if the variable is not set or invalid
+func MustJSONFrom[T any](r *Reader, key string) T {
+	var zero T
+	return must(LookupJSONFrom(r.required(), key, zero))
+}
+
+// DecodeJSON decodes the JSON in environment variable into the value pointed to by v, see DecodeJSON
+func (r *Reader) DecodeJSON(key string, v any) error {
+	val, ok, err := r.lookup(key)
+	if err != nil || !ok {
+		return err
+	}
+
+	if err := decodeJSON(val, v); err != nil {
+		return &ParseError{Key: r.name(key), Value: val, Type: fmt.Sprintf("%T", v), Err: err}
+	}
+
+	return nil
+}
+
+// decodeJSON decodes a single JSON value from s into v in strict mode, errors include the byte offset where decoding failed
+func decodeJSON(s string, v any) error {
+	dec := json.NewDecoder(bytes.NewReader([]byte(s)))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(v); err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+		switch {
+		case errors.As(err, &syntaxErr):
+			return fmt.Errorf("offset %d: %w", syntaxErr.Offset, err)
+		case errors.As(err, &typeErr):
+			return fmt.Errorf("offset %d: %w", typeErr.Offset, err)
+		case errors.Is(err, io.EOF):
+			return fmt.Errorf("offset 0: %w", io.ErrUnexpectedEOF)
+		case strings.HasPrefix(err.Error(), "json: unknown field "):
+			name, _ := strconv.Unquote(strings.TrimPrefix(err.Error(), "json: unknown field "))
+			return fmt.Errorf("offset %d: %w", keyOffset(s, name), err)
+		default:
+			return fmt.Errorf("offset %d: %w", dec.InputOffset(), err)
+		}
+	}
+
+	offset := dec.InputOffset()
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return fmt.Errorf("offset %d: unexpected data after JSON value", offset)
+	}
+
+	return nil
+}
+
+// keyOffset returns the offset right after the first object key named name in the JSON s,
+// DisallowUnknownFields reports the unknown key without its position
+func keyOffset(s, name string) int64 {
+	type container struct {
+		object    bool
+		expectKey bool
+	}
+
+	dec := json.NewDecoder(strings.NewReader(s))
+	var stack []container
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			return dec.InputOffset()
+		}
+
+		if delim, ok := token.(json.Delim); ok && (delim == '{' || delim == '[') {
+			stack = append(stack, container{object: delim == '{', expectKey: delim == '{'})
+			continue
+		}
+		if _, ok := token.(json.Delim); ok {
+			stack = stack[:len(stack)-1]
+		} else if n := len(stack); n > 0 && stack[n-1].object && stack[n-1].expectKey {
+			if token == name {
+				return dec.InputOffset()
+			}
+			stack[n-1].expectKey = false
+			continue
+		}
+
+		if n := len(stack); n > 0 && stack[n-1].object {
+			stack[n-1].expectKey = true
+		}
+	}
+}
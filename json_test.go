@@ -0,0 +1,115 @@
+package env
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testRateLimit struct {
+	Tier  string `json:"tier"`
+	Limit int    `json:"limit"`
+}
+
+func TestReaderGetJSON(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"RATE_LIMITS":   `[{"tier":"free","limit":10},{"tier":"pro","limit":100}]`,
+		"ROUTES":        `{"/api":"backend:8080"}`,
+		"UNKNOWN_FIELD": `[{"tier":"free","limit":10,"burst":5}]`,
+		"WRONG_TYPE":    `[{"tier":"free","limit":"10"}]`,
+		"SYNTAX":        `[{"tier":"free",}]`,
+		"TRAILING":      `[] []`,
+		"EMPTY":         ``,
+	})
+	defaultValue := []testRateLimit{{Tier: "default", Limit: 1}}
+
+	var tests = []struct {
+		kind          string
+		key           string
+		expectedValue []testRateLimit
+		expectedError string
+	}{
+		{"test-valid-value", "RATE_LIMITS", []testRateLimit{{"free", 10}, {"pro", 100}}, ""},
+		{"test-unknown-field", "UNKNOWN_FIELD", defaultValue, `offset 34: json: unknown field "burst"`},
+		{"test-wrong-type", "WRONG_TYPE", defaultValue, "offset 28: json: cannot unmarshal string"},
+		{"test-syntax-error", "SYNTAX", defaultValue, "offset 17: invalid character '}'"},
+		{"test-trailing-data", "TRAILING", defaultValue, "offset 2: unexpected data after JSON value"},
+		{"test-empty-value", "EMPTY", defaultValue, "offset 0: unexpected EOF"},
+		{"test-default-value", "UNSET", defaultValue, ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := LookupJSONFrom(r, tt.key, defaultValue)
+			if !reflect.DeepEqual(result, tt.expectedValue) {
+				t.Errorf("LookupJSONFrom(r, \"%s\", %v): expected %v, actual %v", tt.key, defaultValue, tt.expectedValue, result)
+			}
+
+			if tt.expectedError == "" {
+				if err != nil {
+					t.Errorf("LookupJSONFrom(r, \"%s\", %v): unexpected error %v", tt.key, defaultValue, err)
+				}
+				return
+			}
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) || parseErr.Key != tt.key || !strings.Contains(err.Error(), tt.expectedError) {
+				t.Errorf("LookupJSONFrom(r, \"%s\", %v): expected *ParseError containing %q, actual %v", tt.key, defaultValue, tt.expectedError, err)
+			}
+		})
+	}
+
+	routes := map[string]string{"/": "default"}
+	if err := r.DecodeJSON("ROUTES", &routes); err != nil || routes["/api"] != "backend:8080" || routes["/"] != "default" {
+		t.Errorf("r.DecodeJSON(\"ROUTES\", &routes): unexpected result %v, %v", routes, err)
+	}
+	if err := r.DecodeJSON("UNSET", &routes); err != nil || len(routes) != 2 {
+		t.Errorf("r.DecodeJSON(\"UNSET\", &routes): unexpected result %v, %v", routes, err)
+	}
+	if result := GetJSONFrom(r, "ROUTES", map[string]string(nil)); result["/api"] != "backend:8080" {
+		t.Errorf("GetJSONFrom(r, \"ROUTES\", nil): unexpected result %v", result)
+	}
+	expectPanic(t, "env: UNSET is not set", func() { MustJSONFrom[[]testRateLimit](r, "UNSET") })
+}
+
+func TestLoadJSON(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		RateLimits []testRateLimit    `env:"RATE_LIMITS,json"`
+		Routes     *map[string]string `env:"ROUTES,json" default:"{}"`
+		Invalid    []testRateLimit    `env:"INVALID,json"`
+		Unknown    string             `env:"UNKNOWN,yaml"`
+	}
+
+	r := NewMapReader(map[string]string{
+		"RATE_LIMITS": `[{"tier":"free","limit":10}]`,
+		"INVALID":     `[{"tier":1}]`,
+	})
+
+	var cfg config
+	err := r.Load(&cfg)
+	if !reflect.DeepEqual(cfg.RateLimits, []testRateLimit{{"free", 10}}) {
+		t.Errorf("r.Load(&cfg): unexpected RateLimits %v", cfg.RateLimits)
+	}
+	if cfg.Routes == nil || len(*cfg.Routes) != 0 {
+		t.Errorf("r.Load(&cfg): unexpected Routes %v", cfg.Routes)
+	}
+
+	var errs Errors
+	if !errors.As(err, &errs) || len(errs) != 2 {
+		t.Fatalf("r.Load(&cfg): expected 2 errors, actual %v", err)
+	}
+	var parseErr *ParseError
+	if !errors.As(errs[0], &parseErr) || parseErr.Key != "INVALID" {
+		t.Errorf("r.Load(&cfg): expected *ParseError for INVALID, actual %v", errs[0])
+	}
+	if errs[1].Error() != `env: unknown option "yaml" in env tag of field Unknown` {
+		t.Errorf("r.Load(&cfg): unexpected error %v", errs[1])
+	}
+}
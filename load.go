@@ -0,0 +1,311 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Load populates the struct pointed to by v from environment variables using the field tags:
+//
+//	env:"PORT"        name of the environment variable
+//	env:"ROUTES,json" decodes the value as JSON with the same rules as DecodeJSON, the field can be of any type
+//	default:"8080"    value used when the environment variable is not set
+//	sep:";"           separator used by slice fields, defaults to ","
+//	required:"true"   returns a *NotSetError when the environment variable is not set
+//	prefix:"DB_"      loads a nested struct field with the prefix prepended to its keys
+//	env:",inline"     loads a nested struct field without a prefix
+//
+// Struct fields without an env tag or a prefix tag are loaded without a prefix when they have tagged fields, other fields
+// without tags are left untouched. Nested structs can be pointers, a nil pointer is only allocated when at least one of
+// its fields is set. The prefixes of the reader and of the enclosing structs are prepended to the keys of nested structs.
+// A nested struct of a type that encloses it is reported as an error when it is tagged and skipped otherwise.
+//
+// Every field is evaluated before returning, the returned error is an Errors value listing each
+// missing required variable (*NotSetError) and each invalid value (*ParseError).
+func Load(v any) error {
+	return defaultReader.Load(v)
+}
+
+// Load populates the struct pointed to by v from the reader's environment variables, see Load for the supported tags
+func (r *Reader) Load(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("env: Load expects a non-nil pointer to a struct")
+	}
+
+	var errs Errors
+	r.loadStruct(rv.Elem(), []reflect.Type{rv.Elem().Type()}, &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// loadStruct loads the fields of rv and reports whether any of them was set, stack holds the types of the enclosing structs
+func (r *Reader) loadStruct(rv reflect.Value, stack []reflect.Type, errs *Errors) bool {
+	set := false
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, hasEnv := field.Tag.Lookup("env")
+		prefix, hasPrefix := field.Tag.Lookup("prefix")
+		key, option, _ := strings.Cut(tag, ",")
+
+		switch {
+		case option == "inline" && key != "":
+			*errs = append(*errs, fmt.Errorf("env: inline field %s cannot have a name", field.Name))
+		case option == "inline" || !hasEnv && hasPrefix:
+			if r.WithPrefix(prefix).loadNested(rv.Field(i), field, stack, true, errs) {
+				set = true
+			}
+		case !hasEnv && hasTaggedFields(field.Type, nil):
+			if r.loadNested(rv.Field(i), field, stack, false, errs) {
+				set = true
+			}
+		case !hasEnv:
+		case option != "" && option != "json":
+			*errs = append(*errs, fmt.Errorf("env: unknown option %q in env tag of field %s", option, field.Name))
+		default:
+			fieldSet, err := r.loadField(rv.Field(i), field, key, option == "json")
+			if err != nil {
+				*errs = append(*errs, err)
+			}
+			if fieldSet {
+				set = true
+			}
+		}
+	}
+
+	return set
+}
+
+// loadNested loads a struct or pointer to struct field and reports whether any of its fields was set,
+// tagged is false for the untagged fields loaded because they have tagged fields
+func (r *Reader) loadNested(fv reflect.Value, field reflect.StructField, stack []reflect.Type, tagged bool, errs *Errors) bool {
+	t := fv.Type()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		*errs = append(*errs, fmt.Errorf("env: unsupported type %s for nested field %s", field.Type, field.Name))
+		return false
+	}
+	for _, enclosing := range stack {
+		if enclosing == t && tagged {
+			*errs = append(*errs, fmt.Errorf("env: recursive type %s for nested field %s", field.Type, field.Name))
+		}
+		if enclosing == t {
+			return false
+		}
+	}
+	stack = append(stack, t)
+
+	if fv.Kind() == reflect.Struct {
+		return r.loadStruct(fv, stack, errs)
+	}
+	if !fv.IsNil() {
+		return r.loadStruct(fv.Elem(), stack, errs)
+	}
+
+	ptr := reflect.New(t)
+	set := r.loadStruct(ptr.Elem(), stack, errs)
+	if set {
+		fv.Set(ptr)
+	}
+
+	return set
+}
+
+// hasTaggedFields reports whether t is a struct or pointer to struct with env or prefix tags in its fields or in the
+// fields of its untagged nested structs, visited holds the types already checked
+func hasTaggedFields(t reflect.Type, visited []reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for _, v := range visited {
+		if v == t {
+			return false
+		}
+	}
+	visited = append(visited, t)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		_, hasEnv := field.Tag.Lookup("env")
+		_, hasPrefix := field.Tag.Lookup("prefix")
+		if hasEnv || hasPrefix || hasTaggedFields(field.Type, visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadField loads a field tagged with env and reports whether it was set from the environment or its default
+func (r *Reader) loadField(fv reflect.Value, field reflect.StructField, key string, asJSON bool) (bool, error) {
+	t := field.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if !asJSON && !isSupportedType(t) {
+		return false, fmt.Errorf("env: unsupported type %s for field %s", field.Type, field.Name)
+	}
+
+	val, ok, err := r.lookup(key)
+	if err != nil {
+		return false, err
+	}
+	if !ok && field.Tag.Get("required") == "true" {
+		return false, &NotSetError{Key: r.name(key)}
+	}
+	if !ok {
+		val, ok = field.Tag.Lookup("default")
+	}
+	if !ok {
+		return false, nil
+	}
+
+	if asJSON {
+		ptr := reflect.New(field.Type)
+		if err := decodeJSON(val, ptr.Interface()); err != nil {
+			return false, &ParseError{Key: r.name(key), Value: val, Type: field.Type.String(), Err: err}
+		}
+		fv.Set(ptr.Elem())
+		return true, nil
+	}
+
+	sep, ok := field.Tag.Lookup("sep")
+	if !ok {
+		sep = ","
+	}
+
+	value, err := r.parseValue(val, sep, t)
+	if err != nil {
+		return false, &ParseError{Key: r.name(key), Value: val, Type: t.String(), Err: err}
+	}
+
+	if fv.Kind() == reflect.Ptr {
+		ptr := reflect.New(t)
+		ptr.Elem().Set(value)
+		value = ptr
+	}
+	fv.Set(value)
+
+	return true, nil
+}
+
+func isSupportedType(t reflect.Type) bool {
+	if t.Kind() == reflect.Slice {
+		return t.Elem().Kind() == reflect.Uint8 || isSupportedScalar(t.Elem())
+	}
+
+	return isSupportedScalar(t)
+}
+
+func isSupportedScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+
+	return false
+}
+
+// parseValue parses s into a value of type t, splitting slices by sep with the reader's SliceOptions. Byte slices hold the raw value.
+func (r *Reader) parseValue(s, sep string, t reflect.Type) (reflect.Value, error) {
+	if t.Kind() != reflect.Slice {
+		return r.parseScalar(s, t)
+	}
+
+	if t.Elem().Kind() == reflect.Uint8 {
+		return reflect.ValueOf([]byte(s)).Convert(t), nil
+	}
+
+	items, err := r.sliceOptions.split(s, sep)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	slice := reflect.MakeSlice(t, 0, len(items))
+	for _, item := range items {
+		value, err := r.parseScalar(item, t.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		slice = reflect.Append(slice, value)
+	}
+
+	return slice, nil
+}
+
+// parseScalar parses s into a value of type t with the parser of the Value type of the same kind, following the same
+// rules used by the Get functions. time.Duration values are parsed with ParseDuration and converted back to t.
+func (r *Reader) parseScalar(s string, t reflect.Type) (reflect.Value, error) {
+	var value any
+	var err error
+
+	switch t.Kind() {
+	case reflect.String:
+		value, err = parseAs[string](r, s)
+	case reflect.Bool:
+		value, err = parseAs[bool](r, s)
+	case reflect.Int:
+		value, err = parseAs[int](r, s)
+	case reflect.Int8:
+		value, err = parseAs[int8](r, s)
+	case reflect.Int16:
+		value, err = parseAs[int16](r, s)
+	case reflect.Int32:
+		value, err = parseAs[int32](r, s)
+	case reflect.Int64:
+		if t == durationType {
+			value, err = parseAs[time.Duration](r, s)
+			break
+		}
+		value, err = parseAs[int64](r, s)
+	case reflect.Uint:
+		value, err = parseAs[uint](r, s)
+	case reflect.Uint8:
+		value, err = parseAs[uint8](r, s)
+	case reflect.Uint16:
+		value, err = parseAs[uint16](r, s)
+	case reflect.Uint32:
+		value, err = parseAs[uint32](r, s)
+	case reflect.Uint64:
+		value, err = parseAs[uint64](r, s)
+	case reflect.Float32:
+		value, err = parseAs[float32](r, s)
+	case reflect.Float64:
+		value, err = parseAs[float64](r, s)
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported type %s", t)
+	}
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	return reflect.ValueOf(value).Convert(t), nil
+}
+
+// parseAs parses s with the reader's parser for T
+func parseAs[T Value](r *Reader, s string) (any, error) {
+	return parser[T](r)(s)
+}
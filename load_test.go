@@ -0,0 +1,265 @@
+package env
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type loadDatabaseConfig struct {
+	URL      string `env:"LOAD_DATABASE_URL" required:"true"`
+	MaxConns *int   `env:"LOAD_DATABASE_MAX_CONNS"`
+	MinConns *int   `env:"LOAD_DATABASE_MIN_CONNS"`
+}
+
+type loadConfig struct {
+	Port     int                `env:"LOAD_PORT" default:"8080"`
+	Host     string             `env:"LOAD_HOST" default:"localhost"`
+	Debug    bool               `env:"LOAD_DEBUG"`
+	Ratio    float32            `env:"LOAD_RATIO" default:"0.5"`
+	Timeout  time.Duration      `env:"LOAD_TIMEOUT" default:"1s"`
+	Hosts    []string           `env:"LOAD_HOSTS" sep:" "`
+	Ports    []uint16           `env:"LOAD_PORTS" default:"80,443"`
+	Secret   []byte             `env:"LOAD_SECRET"`
+	Database loadDatabaseConfig `env:",inline"`
+	Cache    *struct {
+		Size int64 `env:"LOAD_CACHE_SIZE"`
+	} `env:",inline"`
+	Untagged string
+	internal string
+}
+
+func TestLoad(t *testing.T) {
+	os.Setenv("LOAD_HOST", "example.com")                  //nolint:errcheck
+	os.Setenv("LOAD_DEBUG", "true")                        //nolint:errcheck
+	os.Setenv("LOAD_TIMEOUT", "1m30s")                     //nolint:errcheck
+	os.Setenv("LOAD_HOSTS", "a b")                         //nolint:errcheck
+	os.Setenv("LOAD_SECRET", "secret")                     //nolint:errcheck
+	os.Setenv("LOAD_DATABASE_URL", "postgres://localhost") //nolint:errcheck
+	os.Setenv("LOAD_DATABASE_MAX_CONNS", "10")             //nolint:errcheck
+	os.Setenv("LOAD_CACHE_SIZE", "1024")                   //nolint:errcheck
+
+	cfg := loadConfig{Untagged: "untouched", internal: "untouched"}
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Load(&cfg): unexpected error %v", err)
+	}
+
+	maxConns := 10
+	expected := loadConfig{
+		Port:     8080,
+		Host:     "example.com",
+		Debug:    true,
+		Ratio:    0.5,
+		Timeout:  90 * time.Second,
+		Hosts:    []string{"a", "b"},
+		Ports:    []uint16{80, 443},
+		Secret:   []byte("secret"),
+		Database: loadDatabaseConfig{URL: "postgres://localhost", MaxConns: &maxConns},
+		Cache: &struct {
+			Size int64 `env:"LOAD_CACHE_SIZE"`
+		}{Size: 1024},
+		Untagged: "untouched",
+		internal: "untouched",
+	}
+	if !reflect.DeepEqual(cfg, expected) {
+		t.Errorf("Load(&cfg): expected %#v, actual %#v", expected, cfg)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	os.Setenv("LOAD_INVALID_INT", "80a0") //nolint:errcheck
+
+	t.Run("test-required", func(t *testing.T) {
+		var cfg struct {
+			Value string `env:"LOAD_REQUIRED" required:"true"`
+		}
+		var notSetErr *NotSetError
+		if err := Load(&cfg); !errors.As(err, &notSetErr) || notSetErr.Key != "LOAD_REQUIRED" {
+			t.Errorf("Load(&cfg): expected *NotSetError, actual %v", err)
+		}
+	})
+
+	t.Run("test-invalid-value", func(t *testing.T) {
+		var cfg struct {
+			Value int8 `env:"LOAD_INVALID_INT"`
+		}
+		var parseErr *ParseError
+		if err := Load(&cfg); !errors.As(err, &parseErr) || parseErr.Key != "LOAD_INVALID_INT" || parseErr.Type != "int8" {
+			t.Errorf("Load(&cfg): expected *ParseError, actual %v", err)
+		}
+	})
+
+	t.Run("test-invalid-default", func(t *testing.T) {
+		var cfg struct {
+			Value []int `env:"LOAD_UNSET" default:"1,x"`
+		}
+		var parseErr *ParseError
+		if err := Load(&cfg); !errors.As(err, &parseErr) || parseErr.Value != "1,x" || parseErr.Type != "[]int" {
+			t.Errorf("Load(&cfg): expected *ParseError, actual %v", err)
+		}
+	})
+
+	t.Run("test-unsupported-type", func(t *testing.T) {
+		var cfg struct {
+			Value map[string]string `env:"LOAD_UNSET"`
+		}
+		if err := Load(&cfg); err == nil {
+			t.Error("Load(&cfg): expected error, actual nil")
+		}
+	})
+
+	t.Run("test-not-a-pointer", func(t *testing.T) {
+		if err := Load(loadConfig{}); err == nil {
+			t.Error("Load(loadConfig{}): expected error, actual nil")
+		}
+	})
+}
+
+func TestLoadAggregatesErrors(t *testing.T) {
+	os.Setenv("LOAD_AGGREGATE_PORT", "80a0") //nolint:errcheck
+	os.Setenv("LOAD_AGGREGATE_DEBUG", "yep") //nolint:errcheck
+
+	var cfg struct {
+		Port     int    `env:"LOAD_AGGREGATE_PORT"`
+		Debug    bool   `env:"LOAD_AGGREGATE_DEBUG"`
+		Database string `env:"LOAD_AGGREGATE_DATABASE_URL" required:"true"`
+		Nested   struct {
+			Key string `env:"LOAD_AGGREGATE_KEY" required:"true"`
+		} `env:",inline"`
+	}
+
+	err := Load(&cfg)
+
+	var errs Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Load(&cfg): expected Errors, actual %#v", err)
+	}
+	if len(errs) != 4 {
+		t.Fatalf("Load(&cfg): expected 4 errors, actual %d: %v", len(errs), err)
+	}
+
+	expected := `env: parsing "80a0" from LOAD_AGGREGATE_PORT as int: strconv.Atoi: parsing "80a0": invalid syntax
+env: parsing "yep" from LOAD_AGGREGATE_DEBUG as bool: strconv.ParseBool: parsing "yep": invalid syntax
+env: LOAD_AGGREGATE_DATABASE_URL is not set
+env: LOAD_AGGREGATE_KEY is not set`
+	if err.Error() != expected {
+		t.Errorf("Load(&cfg): expected %q, actual %q", expected, err.Error())
+	}
+
+	var notSetErr *NotSetError
+	if !errors.As(err, &notSetErr) || notSetErr.Key != "LOAD_AGGREGATE_DATABASE_URL" {
+		t.Errorf("Load(&cfg): expected *NotSetError for LOAD_AGGREGATE_DATABASE_URL, actual %v", notSetErr)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Load(&cfg): expected error to wrap strconv.ErrSyntax")
+	}
+}
+
+func TestLoadPrefix(t *testing.T) {
+	t.Parallel()
+
+	type database struct {
+		Host string `env:"HOST" default:"localhost"`
+		Port int    `env:"PORT" required:"true"`
+	}
+	var cfg struct {
+		Port     int       `env:"PORT"`
+		Database database  `prefix:"DB_"`
+		Replica  *database `prefix:"REPLICA_"`
+		Cache    struct {
+			Size int `env:"CACHE_SIZE"`
+		} `env:",inline"`
+	}
+
+	r := NewMapReader(map[string]string{
+		"BILLING_PORT":       "8080",
+		"BILLING_DB_HOST":    "db",
+		"BILLING_DB_PORT":    "5432",
+		"BILLING_CACHE_SIZE": "64",
+	}).WithPrefix("BILLING_")
+
+	err := r.Load(&cfg)
+
+	var notSetErr *NotSetError
+	if !errors.As(err, &notSetErr) || notSetErr.Key != "BILLING_REPLICA_PORT" {
+		t.Errorf("r.Load(&cfg): expected *NotSetError for BILLING_REPLICA_PORT, actual %v", err)
+	}
+	if cfg.Port != 8080 || cfg.Database != (database{Host: "db", Port: 5432}) || cfg.Replica.Host != "localhost" || cfg.Cache.Size != 64 {
+		t.Errorf("r.Load(&cfg): unexpected result %#v", cfg)
+	}
+}
+
+func TestLoadNestedOptIn(t *testing.T) {
+	t.Parallel()
+
+	type node struct {
+		Name string `env:"NAME"`
+		Next *node
+	}
+	type cyclic struct {
+		Name string  `env:"NAME"`
+		Next *cyclic `prefix:"NEXT_"`
+	}
+	type database struct {
+		Host string `env:"HOST"`
+	}
+	var cfg struct {
+		Port    int `env:"PORT"`
+		Base    *url.URL
+		Replica *database `prefix:"REPLICA_"`
+		Primary *database `prefix:"PRIMARY_"`
+		Invalid int       `env:"INVALID,inline"`
+	}
+
+	r := NewMapReader(map[string]string{"NAME": "head", "PORT": "8080", "PRIMARY_HOST": "db"})
+
+	var n node
+	if err := r.Load(&n); err != nil || n.Name != "head" || n.Next != nil {
+		t.Errorf("r.Load(&n): unexpected result %+v, %v", n, err)
+	}
+
+	var c cyclic
+	if err := r.Load(&c); err == nil || err.Error() != "env: recursive type *env.cyclic for nested field Next" || c.Next != nil {
+		t.Errorf("r.Load(&c): expected a recursive type error, actual %+v, %v", c, err)
+	}
+
+	err := r.Load(&cfg)
+	if cfg.Port != 8080 || cfg.Base != nil || cfg.Replica != nil || cfg.Primary == nil || cfg.Primary.Host != "db" {
+		t.Errorf("r.Load(&cfg): unexpected result %+v", cfg)
+	}
+	if err == nil || err.Error() != "env: inline field Invalid cannot have a name" {
+		t.Errorf("r.Load(&cfg): unexpected error %v", err)
+	}
+}
+
+func TestLoadUntaggedNestedWithTaggedFields(t *testing.T) {
+	t.Parallel()
+
+	var cfg struct {
+		Database struct {
+			URL string `env:"DATABASE_URL" required:"true"`
+		}
+		Cache *struct {
+			Size int `env:"CACHE_SIZE"`
+		}
+		Started time.Time
+	}
+
+	err := NewMapReader(nil).Load(&cfg)
+
+	var notSetErr *NotSetError
+	if !errors.As(err, &notSetErr) || notSetErr.Key != "DATABASE_URL" {
+		t.Errorf("r.Load(&cfg): expected *NotSetError for DATABASE_URL, actual %v", err)
+	}
+	if cfg.Cache != nil || !cfg.Started.IsZero() {
+		t.Errorf("r.Load(&cfg): unexpected result %+v", cfg)
+	}
+
+	if err := NewMapReader(map[string]string{"DATABASE_URL": "postgres://db", "CACHE_SIZE": "64"}).Load(&cfg); err != nil || cfg.Database.URL != "postgres://db" || cfg.Cache == nil || cfg.Cache.Size != 64 {
+		t.Errorf("r.Load(&cfg): unexpected result %+v, %v", cfg, err)
+	}
+}
@@ -0,0 +1,420 @@
+package env
+
+import (
+	b64 "encoding/base64"
+	"time"
+)
+
+// Lookup returns a T value from environment variable or the default value, with a *ParseError if the value is invalid
+func Lookup[T Value](key string, defaultValue T) (T, error) {
+	return LookupFrom(defaultReader, key, defaultValue)
+}
+
+// LookupSlice returns a T slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupSlice[T Value](key, sep string, defaultValue []T) ([]T, error) {
+	return LookupSliceFrom(defaultReader, key, sep, defaultValue)
+}
+
+// LookupString returns a string value from environment variable or the default value
+func LookupString(key, defaultValue string) (string, error) {
+	return defaultReader.LookupString(key, defaultValue)
+}
+
+// LookupStringSlice returns a string slice from environment variable or the default value
+func LookupStringSlice(key, sep string, defaultValue []string) ([]string, error) {
+	return defaultReader.LookupStringSlice(key, sep, defaultValue)
+}
+
+// LookupInt returns a int value from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupInt(key string, defaultValue int) (int, error) {
+	return defaultReader.LookupInt(key, defaultValue)
+}
+
+// LookupIntSlice returns a int slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupIntSlice(key, sep string, defaultValue []int) ([]int, error) {
+	return defaultReader.LookupIntSlice(key, sep, defaultValue)
+}
+
+// LookupInt8 returns a int8 value from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupInt8(key string, defaultValue int8) (int8, error) {
+	return defaultReader.LookupInt8(key, defaultValue)
+}
+
+// LookupInt8Slice returns a int8 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupInt8Slice(key, sep string, defaultValue []int8) ([]int8, error) {
+	return defaultReader.LookupInt8Slice(key, sep, defaultValue)
+}
+
+// LookupInt16 returns a int16 value from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupInt16(key string, defaultValue int16) (int16, error) {
+	return defaultReader.LookupInt16(key, defaultValue)
+}
+
+// LookupInt16Slice returns a int16 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupInt16Slice(key, sep string, defaultValue []int16) ([]int16, error) {
+	return defaultReader.LookupInt16Slice(key, sep, defaultValue)
+}
+
+// LookupInt32 returns a int32 value from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupInt32(key string, defaultValue int32) (int32, error) {
+	return defaultReader.LookupInt32(key, defaultValue)
+}
+
+// LookupInt32Slice returns a int32 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupInt32Slice(key, sep string, defaultValue []int32) ([]int32, error) {
+	return defaultReader.LookupInt32Slice(key, sep, defaultValue)
+}
+
+// LookupInt64 returns a int64 value from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupInt64(key string, defaultValue int64) (int64, error) {
+	return defaultReader.LookupInt64(key, defaultValue)
+}
+
+// LookupInt64Slice returns a int64 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupInt64Slice(key, sep string, defaultValue []int64) ([]int64, error) {
+	return defaultReader.LookupInt64Slice(key, sep, defaultValue)
+}
+
+// LookupUint returns a uint value from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupUint(key string, defaultValue uint) (uint, error) {
+	return defaultReader.LookupUint(key, defaultValue)
+}
+
+// LookupUintSlice returns a uint slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupUintSlice(key, sep string, defaultValue []uint) ([]uint, error) {
+	return defaultReader.LookupUintSlice(key, sep, defaultValue)
+}
+
+// LookupUint8 returns a uint8 value from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupUint8(key string, defaultValue uint8) (uint8, error) {
+	return defaultReader.LookupUint8(key, defaultValue)
+}
+
+// LookupUint8Slice returns a uint8 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupUint8Slice(key, sep string, defaultValue []uint8) ([]uint8, error) {
+	return defaultReader.LookupUint8Slice(key, sep, defaultValue)
+}
+
+// LookupUint16 returns a uint16 value from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupUint16(key string, defaultValue uint16) (uint16, error) {
+	return defaultReader.LookupUint16(key, defaultValue)
+}
+
+// LookupUint16Slice returns a uint16 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupUint16Slice(key, sep string, defaultValue []uint16) ([]uint16, error) {
+	return defaultReader.LookupUint16Slice(key, sep, defaultValue)
+}
+
+// LookupUint32 returns a uint32 value from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupUint32(key string, defaultValue uint32) (uint32, error) {
+	return defaultReader.LookupUint32(key, defaultValue)
+}
+
+// LookupUint32Slice returns a uint32 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupUint32Slice(key, sep string, defaultValue []uint32) ([]uint32, error) {
+	return defaultReader.LookupUint32Slice(key, sep, defaultValue)
+}
+
+// LookupUint64 returns a uint64 value from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupUint64(key string, defaultValue uint64) (uint64, error) {
+	return defaultReader.LookupUint64(key, defaultValue)
+}
+
+// LookupUint64Slice returns a uint64 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupUint64Slice(key, sep string, defaultValue []uint64) ([]uint64, error) {
+	return defaultReader.LookupUint64Slice(key, sep, defaultValue)
+}
+
+// LookupBool returns a boolean value from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupBool(key string, defaultValue bool) (bool, error) {
+	return defaultReader.LookupBool(key, defaultValue)
+}
+
+// LookupBoolSlice returns a boolean slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupBoolSlice(key, sep string, defaultValue []bool) ([]bool, error) {
+	return defaultReader.LookupBoolSlice(key, sep, defaultValue)
+}
+
+// LookupFloat32 returns a float32 value from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupFloat32(key string, defaultValue float32) (float32, error) {
+	return defaultReader.LookupFloat32(key, defaultValue)
+}
+
+// LookupFloat32Slice returns a float32 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupFloat32Slice(key, sep string, defaultValue []float32) ([]float32, error) {
+	return defaultReader.LookupFloat32Slice(key, sep, defaultValue)
+}
+
+// LookupFloat64 returns a float64 value from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupFloat64(key string, defaultValue float64) (float64, error) {
+	return defaultReader.LookupFloat64(key, defaultValue)
+}
+
+// LookupFloat64Slice returns a float64 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupFloat64Slice(key, sep string, defaultValue []float64) ([]float64, error) {
+	return defaultReader.LookupFloat64Slice(key, sep, defaultValue)
+}
+
+// LookupBytes returns a byte slice value from environment variable or the default value
+func LookupBytes(key string, defaultValue []byte) ([]byte, error) {
+	return defaultReader.LookupBytes(key, defaultValue)
+}
+
+// LookupDuration returns a time.Duration value from environment variable or the default value, with a *ParseError if the value is invalid.
+// Plain integers are multiplied by duration, other values are parsed with ParseDuration, ex: 1m30s or 2d.
+func LookupDuration(key string, defaultValue int64, duration time.Duration) (time.Duration, error) {
+	return defaultReader.LookupDuration(key, defaultValue, duration)
+}
+
+// LookupDurationSlice returns a time.Duration slice from environment variable or the default value, with a *ParseError if any item is invalid.
+// Plain integers are multiplied by duration, other values are parsed with ParseDuration, ex: 250ms,1s,2s.
+func LookupDurationSlice(key, sep string, defaultValue []time.Duration, duration time.Duration) ([]time.Duration, error) {
+	return defaultReader.LookupDurationSlice(key, sep, defaultValue, duration)
+}
+
+// LookupBase64ToBytes converts a base64 string to a byte slice value from the environment variable or the default value, with a *ParseError if the value is invalid
+func LookupBase64ToBytes(key string, defaultValue []byte) ([]byte, error) {
+	return defaultReader.LookupBase64ToBytes(key, defaultValue)
+}
+
+// LookupBase64ToString converts a base64 string to a string value from the environment variable or the default value, with a *ParseError if the value is invalid
+func LookupBase64ToString(key string, defaultValue string) (string, error) {
+	return defaultReader.LookupBase64ToString(key, defaultValue)
+}
+
+// LookupFrom returns a T value from the reader's environment variable or the default value, with a *ParseError if the value is invalid
+func LookupFrom[T Value](r *Reader, key string, defaultValue T) (T, error) {
+	return lookupWith(r, key, defaultValue, typeName[T](), parser[T](r))
+}
+
+// LookupSliceFrom returns a T slice from the reader's environment variable or the default value, with a *ParseError if any item is invalid
+func LookupSliceFrom[T Value](r *Reader, key, sep string, defaultValue []T) ([]T, error) {
+	return lookupSliceWith(r, key, sep, defaultValue, typeName[[]T](), parser[T](r))
+}
+
+// lookupWith returns the value of the environment variable converted by parse or the default value,
+// with a *ParseError naming typ if the value is invalid
+func lookupWith[T any](r *Reader, key string, defaultValue T, typ string, parse func(string) (T, error)) (T, error) {
+	result, ok, err := lookupValue(r, key, typ, parse)
+	if err != nil || !ok {
+		return defaultValue, err
+	}
+
+	return result, nil
+}
+
+// lookupValue returns the value of the environment variable converted by parse and whether it is set,
+// with a *ParseError naming typ if the value is invalid
+func lookupValue[T any](r *Reader, key, typ string, parse func(string) (T, error)) (T, bool, error) {
+	var zero T
+	val, ok, err := r.lookup(key)
+	if err != nil || !ok {
+		return zero, ok, err
+	}
+
+	result, err := parse(val)
+	if err != nil {
+		return zero, true, &ParseError{Key: r.name(key), Value: val, Type: typ, Err: err}
+	}
+
+	return result, true, nil
+}
+
+// lookupSliceWith returns the items of the environment variable split by sep with the reader's SliceOptions and converted by parse or the default value,
+// with a *ParseError naming typ if any item is invalid
+func lookupSliceWith[T any](r *Reader, key, sep string, defaultValue []T, typ string, parse func(string) (T, error)) ([]T, error) {
+	return lookupWith(r, key, defaultValue, typ, sliceParser(r, sep, parse))
+}
+
+// sliceParser returns a function splitting values by sep with the reader's SliceOptions and converting each item with parse
+func sliceParser[T any](r *Reader, sep string, parse func(string) (T, error)) func(string) ([]T, error) {
+	return func(s string) ([]T, error) {
+		items, err := r.sliceOptions.split(s, sep)
+		if err != nil {
+			return nil, err
+		}
+
+		var slice []T
+		for _, item := range items {
+			result, err := parse(item)
+			if err != nil {
+				return nil, err
+			}
+			slice = append(slice, result)
+		}
+
+		return slice, nil
+	}
+}
+
+// LookupString returns a string value from environment variable or the default value
+func (r *Reader) LookupString(key, defaultValue string) (string, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupStringSlice returns a string slice from environment variable or the default value
+func (r *Reader) LookupStringSlice(key, sep string, defaultValue []string) ([]string, error) {
+	return LookupSliceFrom(r, key, sep, defaultValue)
+}
+
+// LookupInt returns a int value from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupInt(key string, defaultValue int) (int, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupIntSlice returns a int slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupIntSlice(key, sep string, defaultValue []int) ([]int, error) {
+	return LookupSliceFrom(r, key, sep, defaultValue)
+}
+
+// LookupInt8 returns a int8 value from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupInt8(key string, defaultValue int8) (int8, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupInt8Slice returns a int8 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupInt8Slice(key, sep string, defaultValue []int8) ([]int8, error) {
+	return LookupSliceFrom(r, key, sep, defaultValue)
+}
+
+// LookupInt16 returns a int16 value from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupInt16(key string, defaultValue int16) (int16, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupInt16Slice returns a int16 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupInt16Slice(key, sep string, defaultValue []int16) ([]int16, error) {
+	return LookupSliceFrom(r, key, sep, defaultValue)
+}
+
+// LookupInt32 returns a int32 value from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupInt32(key string, defaultValue int32) (int32, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupInt32Slice returns a int32 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupInt32Slice(key, sep string, defaultValue []int32) ([]int32, error) {
+	return LookupSliceFrom(r, key, sep, defaultValue)
+}
+
+// LookupInt64 returns a int64 value from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupInt64(key string, defaultValue int64) (int64, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupInt64Slice returns a int64 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupInt64Slice(key, sep string, defaultValue []int64) ([]int64, error) {
+	return LookupSliceFrom(r, key, sep, defaultValue)
+}
+
+// LookupUint returns a uint value from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupUint(key string, defaultValue uint) (uint, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupUintSlice returns a uint slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupUintSlice(key, sep string, defaultValue []uint) ([]uint, error) {
+	return LookupSliceFrom(r, key, sep, defaultValue)
+}
+
+// LookupUint8 returns a uint8 value from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupUint8(key string, defaultValue uint8) (uint8, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupUint8Slice returns a uint8 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupUint8Slice(key, sep string, defaultValue []uint8) ([]uint8, error) {
+	return LookupSliceFrom(r, key, sep, defaultValue)
+}
+
+// LookupUint16 returns a uint16 value from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupUint16(key string, defaultValue uint16) (uint16, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupUint16Slice returns a uint16 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupUint16Slice(key, sep string, defaultValue []uint16) ([]uint16, error) {
+	return LookupSliceFrom(r, key, sep, defaultValue)
+}
+
+// LookupUint32 returns a uint32 value from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupUint32(key string, defaultValue uint32) (uint32, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupUint32Slice returns a uint32 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupUint32Slice(key, sep string, defaultValue []uint32) ([]uint32, error) {
+	return LookupSliceFrom(r, key, sep, defaultValue)
+}
+
+// LookupUint64 returns a uint64 value from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupUint64(key string, defaultValue uint64) (uint64, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupUint64Slice returns a uint64 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupUint64Slice(key, sep string, defaultValue []uint64) ([]uint64, error) {
+	return LookupSliceFrom(r, key, sep, defaultValue)
+}
+
+// LookupBool returns a boolean value from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupBool(key string, defaultValue bool) (bool, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupBoolSlice returns a boolean slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupBoolSlice(key, sep string, defaultValue []bool) ([]bool, error) {
+	return LookupSliceFrom(r, key, sep, defaultValue)
+}
+
+// LookupFloat32 returns a float32 value from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupFloat32(key string, defaultValue float32) (float32, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupFloat32Slice returns a float32 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupFloat32Slice(key, sep string, defaultValue []float32) ([]float32, error) {
+	return LookupSliceFrom(r, key, sep, defaultValue)
+}
+
+// LookupFloat64 returns a float64 value from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupFloat64(key string, defaultValue float64) (float64, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupFloat64Slice returns a float64 slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupFloat64Slice(key, sep string, defaultValue []float64) ([]float64, error) {
+	return LookupSliceFrom(r, key, sep, defaultValue)
+}
+
+// LookupBytes returns a byte slice value from environment variable or the default value
+func (r *Reader) LookupBytes(key string, defaultValue []byte) ([]byte, error) {
+	return LookupFrom(r, key, defaultValue)
+}
+
+// LookupDuration returns a time.Duration value from environment variable or the default value, see LookupDuration
+func (r *Reader) LookupDuration(key string, defaultValue int64, duration time.Duration) (time.Duration, error) {
+	return lookupWith(r, key, time.Duration(defaultValue)*duration, "time.Duration", func(s string) (time.Duration, error) {
+		return parseDuration(s, duration)
+	})
+}
+
+// LookupDurationSlice returns a time.Duration slice from environment variable or the default value, see LookupDurationSlice
+func (r *Reader) LookupDurationSlice(key, sep string, defaultValue []time.Duration, duration time.Duration) ([]time.Duration, error) {
+	return lookupSliceWith(r, key, sep, defaultValue, "[]time.Duration", func(s string) (time.Duration, error) {
+		return parseDuration(s, duration)
+	})
+}
+
+// LookupBase64ToBytes converts a base64 string to a byte slice value from the environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupBase64ToBytes(key string, defaultValue []byte) ([]byte, error) {
+	return lookupWith(r, key, defaultValue, "base64", b64.StdEncoding.DecodeString)
+}
+
+// LookupBase64ToString converts a base64 string to a string value from the environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupBase64ToString(key string, defaultValue string) (string, error) {
+	return lookupWith(r, key, defaultValue, "base64", func(s string) (string, error) {
+		result, err := b64.StdEncoding.DecodeString(s)
+		return string(result), err
+	})
+}
@@ -0,0 +1,133 @@
+package env
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestLookupInt(t *testing.T) {
+	os.Setenv("LOOKUP_INT2", "2")    //nolint:errcheck
+	os.Setenv("LOOKUP_INT3", "80a0") //nolint:errcheck
+
+	var tests = []struct {
+		kind          string
+		key           string
+		defaultValue  int
+		expectedValue int
+		expectedError bool
+	}{
+		{"test-default-value", "LOOKUP_INT1", 1, 1, false},
+		{"test-value-from-envvar", "LOOKUP_INT2", 1, 2, false},
+		{"test-invalid-value-from-envvar", "LOOKUP_INT3", 3, 3, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			result, err := LookupInt(tt.key, tt.defaultValue)
+			if result != tt.expectedValue {
+				t.Errorf("LookupInt(\"%s\", %d): expected %d, actual %d", tt.key, tt.defaultValue, tt.expectedValue, result)
+			}
+			if (err != nil) != tt.expectedError {
+				t.Errorf("LookupInt(\"%s\", %d): expected error %v, actual %v", tt.key, tt.defaultValue, tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestLookupUint16Slice(t *testing.T) {
+	os.Setenv("LOOKUP_UINT16_2", "1,2")     //nolint:errcheck
+	os.Setenv("LOOKUP_UINT16_3", "1,70000") //nolint:errcheck
+
+	var tests = []struct {
+		kind          string
+		key           string
+		sep           string
+		defaultValue  []uint16
+		expectedValue []uint16
+		expectedError bool
+	}{
+		{"test-default-value", "LOOKUP_UINT16_1", ",", []uint16{1}, []uint16{1}, false},
+		{"test-value-from-envvar", "LOOKUP_UINT16_2", ",", []uint16{1}, []uint16{1, 2}, false},
+		{"test-invalid-value-from-envvar", "LOOKUP_UINT16_3", ",", []uint16{3}, []uint16{3}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			result, err := LookupUint16Slice(tt.key, tt.sep, tt.defaultValue)
+			if !reflect.DeepEqual(result, tt.expectedValue) {
+				t.Errorf("LookupUint16Slice(\"%s\", \"%s\", %#v): expected %#v, actual %#v", tt.key, tt.sep, tt.defaultValue, tt.expectedValue, result)
+			}
+			if (err != nil) != tt.expectedError {
+				t.Errorf("LookupUint16Slice(\"%s\", \"%s\", %#v): expected error %v, actual %v", tt.key, tt.sep, tt.defaultValue, tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestLookupBase64ToBytes(t *testing.T) {
+	os.Setenv("LOOKUP_BASE64-2", "SGVsbG8gV29ybGQgMg==") //nolint:errcheck
+	os.Setenv("LOOKUP_BASE64-3", "invalid-base64-value") //nolint:errcheck
+
+	var tests = []struct {
+		kind          string
+		key           string
+		defaultValue  []byte
+		expectedValue []byte
+		expectedError bool
+	}{
+		{"test-default-value", "LOOKUP_BASE64-1", []byte("Hello World 1"), []byte("Hello World 1"), false},
+		{"test-value-from-envvar", "LOOKUP_BASE64-2", []byte("Hello World 1"), []byte("Hello World 2"), false},
+		{"test-invalid-value-from-envvar", "LOOKUP_BASE64-3", []byte("Hello World 1"), []byte("Hello World 1"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			result, err := LookupBase64ToBytes(tt.key, tt.defaultValue)
+			if !bytes.Equal(result, tt.expectedValue) {
+				t.Errorf("LookupBase64ToBytes(\"%s\", %#v): expected %#v, actual %#v", tt.key, tt.defaultValue, tt.expectedValue, result)
+			}
+			if (err != nil) != tt.expectedError {
+				t.Errorf("LookupBase64ToBytes(\"%s\", %#v): expected error %v, actual %v", tt.key, tt.defaultValue, tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	os.Setenv("LOOKUP_PORT", "80a0") //nolint:errcheck
+
+	_, err := LookupInt("LOOKUP_PORT", 8080)
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("LookupInt(\"LOOKUP_PORT\", 8080): expected *ParseError, actual %#v", err)
+	}
+	if parseErr.Key != "LOOKUP_PORT" || parseErr.Value != "80a0" || parseErr.Type != "int" {
+		t.Errorf("LookupInt(\"LOOKUP_PORT\", 8080): unexpected error fields %#v", parseErr)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("LookupInt(\"LOOKUP_PORT\", 8080): expected error to wrap strconv.ErrSyntax, actual %v", err)
+	}
+	expected := `env: parsing "80a0" from LOOKUP_PORT as int: strconv.Atoi: parsing "80a0": invalid syntax`
+	if err.Error() != expected {
+		t.Errorf("LookupInt(\"LOOKUP_PORT\", 8080): expected %q, actual %q", expected, err.Error())
+	}
+}
+
+func TestLookupSlice(t *testing.T) {
+	os.Setenv("LOOKUP_SLICE", "1,2,x") //nolint:errcheck
+
+	result, err := LookupSlice("LOOKUP_SLICE", ",", []float64{1})
+	if !reflect.DeepEqual(result, []float64{1}) {
+		t.Errorf("LookupSlice(\"LOOKUP_SLICE\", \",\", []float64{1}): expected %#v, actual %#v", []float64{1}, result)
+	}
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Type != "[]float64" || parseErr.Value != "1,2,x" {
+		t.Errorf("LookupSlice(\"LOOKUP_SLICE\", \",\", []float64{1}): expected *ParseError, actual %v", err)
+	}
+}
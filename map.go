@@ -0,0 +1,212 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DuplicateKeyPolicy defines how the map functions handle keys that appear more than once
+type DuplicateKeyPolicy int
+
+const (
+	// DuplicateKeyError rejects the value with a *ParseError, it is the default policy
+	DuplicateKeyError DuplicateKeyPolicy = iota
+	// DuplicateKeyFirst keeps the first value of the key
+	DuplicateKeyFirst
+	// DuplicateKeyLast keeps the last value of the key
+	DuplicateKeyLast
+)
+
+// ErrDuplicateKey is wrapped by the *ParseError returned when a key appears more than once with the DuplicateKeyError policy
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// WithDuplicateKeys returns a reader that applies the policy to keys that appear more than once in map values
+func WithDuplicateKeys(policy DuplicateKeyPolicy) *Reader {
+	return defaultReader.WithDuplicateKeys(policy)
+}
+
+// WithDuplicateKeys returns a copy of the reader that applies the policy to keys that appear more than once in map values
+func (r *Reader) WithDuplicateKeys(policy DuplicateKeyPolicy) *Reader {
+	c := *r
+	c.duplicateKeys = policy
+	return &c
+}
+
+// GetMap returns a map from environment variable or the default value, the pairs are split by pairSep and the key from the value by kvSep, ex: X-A=1,X-B=2
+func GetMap[T Value](key, pairSep, kvSep string, defaultValue map[string]T) map[string]T {
+	return GetMapFrom(defaultReader, key, pairSep, kvSep, defaultValue)
+}
+
+// LookupMap returns a map from environment variable or the default value, with a *ParseError if any pair is malformed or any value is invalid
+func LookupMap[T Value](key, pairSep, kvSep string, defaultValue map[string]T) (map[string]T, error) {
+	return LookupMapFrom(defaultReader, key, pairSep, kvSep, defaultValue)
+}
+
+// GetMapFrom returns a map from the reader's environment variable or the default value, see GetMap
+func GetMapFrom[T Value](r *Reader, key, pairSep, kvSep string, defaultValue map[string]T) map[string]T {
+	value, _ := LookupMapFrom(r, key, pairSep, kvSep, defaultValue)
+	return value
+}
+
+// LookupMapFrom returns a map from the reader's environment variable or the default value, see LookupMap
+func LookupMapFrom[T Value](r *Reader, key, pairSep, kvSep string, defaultValue map[string]T) (map[string]T, error) {
+	return lookupWith(r, key, defaultValue, typeName[map[string]T](), func(s string) (map[string]T, error) {
+		return parseMap(s, pairSep, kvSep, r.sliceOptions, r.duplicateKeys, parser[T](r))
+	})
+}
+
+// GetStringMap returns a map[string]string from environment variable or the default value
+func GetStringMap(key, pairSep, kvSep string, defaultValue map[string]string) map[string]string {
+	return defaultReader.GetStringMap(key, pairSep, kvSep, defaultValue)
+}
+
+// GetIntMap returns a map[string]int from environment variable or the default value
+func GetIntMap(key, pairSep, kvSep string, defaultValue map[string]int) map[string]int {
+	return defaultReader.GetIntMap(key, pairSep, kvSep, defaultValue)
+}
+
+// GetBoolMap returns a map[string]bool from environment variable or the default value
+func GetBoolMap(key, pairSep, kvSep string, defaultValue map[string]bool) map[string]bool {
+	return defaultReader.GetBoolMap(key, pairSep, kvSep, defaultValue)
+}
+
+// GetDurationMap returns a map[string]time.Duration from environment variable or the default value
+func GetDurationMap(key, pairSep, kvSep string, defaultValue map[string]time.Duration) map[string]time.Duration {
+	return defaultReader.GetDurationMap(key, pairSep, kvSep, defaultValue)
+}
+
+// LookupStringMap returns a map[string]string from environment variable or the default value, with a *ParseError if any pair is malformed or invalid
+func LookupStringMap(key, pairSep, kvSep string, defaultValue map[string]string) (map[string]string, error) {
+	return defaultReader.LookupStringMap(key, pairSep, kvSep, defaultValue)
+}
+
+// LookupIntMap returns a map[string]int from environment variable or the default value, with a *ParseError if any pair is malformed or invalid
+func LookupIntMap(key, pairSep, kvSep string, defaultValue map[string]int) (map[string]int, error) {
+	return defaultReader.LookupIntMap(key, pairSep, kvSep, defaultValue)
+}
+
+// LookupBoolMap returns a map[string]bool from environment variable or the default value, with a *ParseError if any pair is malformed or invalid
+func LookupBoolMap(key, pairSep, kvSep string, defaultValue map[string]bool) (map[string]bool, error) {
+	return defaultReader.LookupBoolMap(key, pairSep, kvSep, defaultValue)
+}
+
+// LookupDurationMap returns a map[string]time.Duration from environment variable or the default value, with a *ParseError if any pair is malformed or invalid
+func LookupDurationMap(key, pairSep, kvSep string, defaultValue map[string]time.Duration) (map[string]time.Duration, error) {
+	return defaultReader.LookupDurationMap(key, pairSep, kvSep, defaultValue)
+}
+
+// MustStringMap returns a map[string]string from environment variable, it panics if the variable is not set or invalid
+func MustStringMap(key, pairSep, kvSep string) map[string]string {
+	return defaultReader.MustStringMap(key, pairSep, kvSep)
+}
+
+// MustIntMap returns a map[string]int from environment variable, it panics if the variable is not set or invalid
+func MustIntMap(key, pairSep, kvSep string) map[string]int {
+	return defaultReader.MustIntMap(key, pairSep, kvSep)
+}
+
+// MustBoolMap returns a map[string]bool from environment variable, it panics if the variable is not set or invalid
+func MustBoolMap(key, pairSep, kvSep string) map[string]bool {
+	return defaultReader.MustBoolMap(key, pairSep, kvSep)
+}
+
+// MustDurationMap returns a map[string]time.Duration from environment variable, it panics if the variable is not set or invalid
+func MustDurationMap(key, pairSep, kvSep string) map[string]time.Duration {
+	return defaultReader.MustDurationMap(key, pairSep, kvSep)
+}
+
+// GetStringMap returns a map[string]string from environment variable or the default value
+func (r *Reader) GetStringMap(key, pairSep, kvSep string, defaultValue map[string]string) map[string]string {
+	return GetMapFrom(r, key, pairSep, kvSep, defaultValue)
+}
+
+// GetIntMap returns a map[string]int from environment variable or the default value
+func (r *Reader) GetIntMap(key, pairSep, kvSep string, defaultValue map[string]int) map[string]int {
+	return GetMapFrom(r, key, pairSep, kvSep, defaultValue)
+}
+
+// GetBoolMap returns a map[string]bool from environment variable or the default value
+func (r *Reader) GetBoolMap(key, pairSep, kvSep string, defaultValue map[string]bool) map[string]bool {
+	return GetMapFrom(r, key, pairSep, kvSep, defaultValue)
+}
+
+// GetDurationMap returns a map[string]time.Duration from environment variable or the default value
+func (r *Reader) GetDurationMap(key, pairSep, kvSep string, defaultValue map[string]time.Duration) map[string]time.Duration {
+	return GetMapFrom(r, key, pairSep, kvSep, defaultValue)
+}
+
+// LookupStringMap returns a map[string]string from environment variable or the default value, with a *ParseError if any pair is malformed or invalid
+func (r *Reader) LookupStringMap(key, pairSep, kvSep string, defaultValue map[string]string) (map[string]string, error) {
+	return LookupMapFrom(r, key, pairSep, kvSep, defaultValue)
+}
+
+// LookupIntMap returns a map[string]int from environment variable or the default value, with a *ParseError if any pair is malformed or invalid
+func (r *Reader) LookupIntMap(key, pairSep, kvSep string, defaultValue map[string]int) (map[string]int, error) {
+	return LookupMapFrom(r, key, pairSep, kvSep, defaultValue)
+}
+
+// LookupBoolMap returns a map[string]bool from environment variable or the default value, with a *ParseError if any pair is malformed or invalid
+func (r *Reader) LookupBoolMap(key, pairSep, kvSep string, defaultValue map[string]bool) (map[string]bool, error) {
+	return LookupMapFrom(r, key, pairSep, kvSep, defaultValue)
+}
+
+// LookupDurationMap returns a map[string]time.Duration from environment variable or the default value, with a *ParseError if any pair is malformed or invalid
+func (r *Reader) LookupDurationMap(key, pairSep, kvSep string, defaultValue map[string]time.Duration) (map[string]time.Duration, error) {
+	return LookupMapFrom(r, key, pairSep, kvSep, defaultValue)
+}
+
+// MustStringMap returns a map[string]string from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustStringMap(key, pairSep, kvSep string) map[string]string {
+	return must(r.required().LookupStringMap(key, pairSep, kvSep, nil))
+}
+
+// MustIntMap returns a map[string]int from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustIntMap(key, pairSep, kvSep string) map[string]int {
+	return must(r.required().LookupIntMap(key, pairSep, kvSep, nil))
+}
+
+// MustBoolMap returns a map[string]bool from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustBoolMap(key, pairSep, kvSep string) map[string]bool {
+	return must(r.required().LookupBoolMap(key, pairSep, kvSep, nil))
+}
+
+// MustDurationMap returns a map[string]time.Duration from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustDurationMap(key, pairSep, kvSep string) map[string]time.Duration {
+	return must(r.required().LookupDurationMap(key, pairSep, kvSep, nil))
+}
+
+// parseMap splits s into pairs by pairSep with the options and each pair into key and value by the first kvSep, converting the values with parse
+func parseMap[T any](s, pairSep, kvSep string, options SliceOptions, duplicateKeys DuplicateKeyPolicy, parse func(string) (T, error)) (map[string]T, error) {
+	pairs, err := options.split(s, pairSep)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]T)
+	for _, pair := range pairs {
+		k, v, ok := strings.Cut(pair, kvSep)
+		if !ok || k == "" {
+			return nil, fmt.Errorf("malformed pair %q", pair)
+		}
+
+		value, err := parse(v)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, exists := result[k]; exists {
+			switch duplicateKeys {
+			case DuplicateKeyFirst:
+				continue
+			case DuplicateKeyLast:
+			default:
+				return nil, fmt.Errorf("%w %q", ErrDuplicateKey, k)
+			}
+		}
+		result[k] = value
+	}
+
+	return result, nil
+}
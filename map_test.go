@@ -0,0 +1,86 @@
+package env
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReaderGetMap(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"EXTRA_HEADERS": "X-A=1,X-B=2",
+		"LABELS":        "app:api;tier:backend;url:http://example.com",
+		"WEIGHTS":       "a=1,b=2",
+		"FEATURES":      "search=true,beta=false",
+		"TIMEOUTS":      "read=1s,write=2m",
+		"MALFORMED":     "a=1,b",
+		"EMPTY_KEY":     "=1",
+		"INVALID_VALUE": "a=1,b=x",
+		"DUPLICATE":     "a=1,b=2,a=3",
+	})
+
+	if result := r.GetStringMap("EXTRA_HEADERS", ",", "=", nil); !reflect.DeepEqual(result, map[string]string{"X-A": "1", "X-B": "2"}) {
+		t.Errorf("r.GetStringMap(\"EXTRA_HEADERS\", \",\", \"=\", nil): unexpected result %#v", result)
+	}
+	if result := r.GetStringMap("LABELS", ";", ":", nil); !reflect.DeepEqual(result, map[string]string{"app": "api", "tier": "backend", "url": "http://example.com"}) {
+		t.Errorf("r.GetStringMap(\"LABELS\", \";\", \":\", nil): unexpected result %#v", result)
+	}
+	if result := r.GetIntMap("WEIGHTS", ",", "=", nil); !reflect.DeepEqual(result, map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("r.GetIntMap(\"WEIGHTS\", \",\", \"=\", nil): unexpected result %#v", result)
+	}
+	if result := r.GetBoolMap("FEATURES", ",", "=", nil); !reflect.DeepEqual(result, map[string]bool{"search": true, "beta": false}) {
+		t.Errorf("r.GetBoolMap(\"FEATURES\", \",\", \"=\", nil): unexpected result %#v", result)
+	}
+	if result := r.GetDurationMap("TIMEOUTS", ",", "=", nil); !reflect.DeepEqual(result, map[string]time.Duration{"read": time.Second, "write": 2 * time.Minute}) {
+		t.Errorf("r.GetDurationMap(\"TIMEOUTS\", \",\", \"=\", nil): unexpected result %#v", result)
+	}
+	if result := GetMapFrom(r, "WEIGHTS", ",", "=", map[string]float64{}); !reflect.DeepEqual(result, map[string]float64{"a": 1, "b": 2}) {
+		t.Errorf("GetMapFrom(r, \"WEIGHTS\", \",\", \"=\", nil): unexpected result %#v", result)
+	}
+
+	defaultValue := map[string]int{"default": 1}
+	var tests = []struct {
+		kind          string
+		key           string
+		expectedError string
+	}{
+		{"test-malformed-pair", "MALFORMED", `env: parsing "a=1,b" from MALFORMED as map[string]int: malformed pair "b"`},
+		{"test-empty-key", "EMPTY_KEY", `env: parsing "=1" from EMPTY_KEY as map[string]int: malformed pair "=1"`},
+		{"test-invalid-value", "INVALID_VALUE", `env: parsing "a=1,b=x" from INVALID_VALUE as map[string]int: strconv.Atoi: parsing "x": invalid syntax`},
+		{"test-duplicate-key", "DUPLICATE", `env: parsing "a=1,b=2,a=3" from DUPLICATE as map[string]int: duplicate key "a"`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := r.LookupIntMap(tt.key, ",", "=", defaultValue)
+			if err == nil || err.Error() != tt.expectedError {
+				t.Errorf("r.LookupIntMap(\"%s\", \",\", \"=\", %#v): expected %q, actual %v", tt.key, defaultValue, tt.expectedError, err)
+			}
+			if !reflect.DeepEqual(result, defaultValue) {
+				t.Errorf("r.LookupIntMap(\"%s\", \",\", \"=\", %#v): expected the default value, actual %#v", tt.key, defaultValue, result)
+			}
+		})
+	}
+}
+
+func TestWithDuplicateKeys(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{"DUPLICATE": "a=1,b=2,a=3"})
+
+	if _, err := r.LookupIntMap("DUPLICATE", ",", "=", nil); !errors.Is(err, ErrDuplicateKey) {
+		t.Errorf("r.LookupIntMap(\"DUPLICATE\", \",\", \"=\", nil): expected ErrDuplicateKey, actual %v", err)
+	}
+	if result := r.WithDuplicateKeys(DuplicateKeyFirst).GetIntMap("DUPLICATE", ",", "=", nil); !reflect.DeepEqual(result, map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("DuplicateKeyFirst: unexpected result %#v", result)
+	}
+	if result := r.WithDuplicateKeys(DuplicateKeyLast).GetIntMap("DUPLICATE", ",", "=", nil); !reflect.DeepEqual(result, map[string]int{"a": 3, "b": 2}) {
+		t.Errorf("DuplicateKeyLast: unexpected result %#v", result)
+	}
+}
@@ -0,0 +1,352 @@
+package env
+
+import (
+	"time"
+)
+
+// must panics if err is not nil, otherwise it returns the value
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// MustString returns a string value from environment variable, it panics if the variable is not set
+func MustString(key string) string {
+	return defaultReader.MustString(key)
+}
+
+// MustStringSlice returns a string slice from environment variable, it panics if the variable is not set
+func MustStringSlice(key, sep string) []string {
+	return defaultReader.MustStringSlice(key, sep)
+}
+
+// MustInt returns a int value from environment variable, it panics if the variable is not set or invalid
+func MustInt(key string) int {
+	return defaultReader.MustInt(key)
+}
+
+// MustIntSlice returns a int slice from environment variable, it panics if the variable is not set or invalid
+func MustIntSlice(key, sep string) []int {
+	return defaultReader.MustIntSlice(key, sep)
+}
+
+// MustInt8 returns a int8 value from environment variable, it panics if the variable is not set or invalid
+func MustInt8(key string) int8 {
+	return defaultReader.MustInt8(key)
+}
+
+// MustInt8Slice returns a int8 slice from environment variable, it panics if the variable is not set or invalid
+func MustInt8Slice(key, sep string) []int8 {
+	return defaultReader.MustInt8Slice(key, sep)
+}
+
+// MustInt16 returns a int16 value from environment variable, it panics if the variable is not set or invalid
+func MustInt16(key string) int16 {
+	return defaultReader.MustInt16(key)
+}
+
+// MustInt16Slice returns a int16 slice from environment variable, it panics if the variable is not set or invalid
+func MustInt16Slice(key, sep string) []int16 {
+	return defaultReader.MustInt16Slice(key, sep)
+}
+
+// MustInt32 returns a int32 value from environment variable, it panics if the variable is not set or invalid
+func MustInt32(key string) int32 {
+	return defaultReader.MustInt32(key)
+}
+
+// MustInt32Slice returns a int32 slice from environment variable, it panics if the variable is not set or invalid
+func MustInt32Slice(key, sep string) []int32 {
+	return defaultReader.MustInt32Slice(key, sep)
+}
+
+// MustInt64 returns a int64 value from environment variable, it panics if the variable is not set or invalid
+func MustInt64(key string) int64 {
+	return defaultReader.MustInt64(key)
+}
+
+// MustInt64Slice returns a int64 slice from environment variable, it panics if the variable is not set or invalid
+func MustInt64Slice(key, sep string) []int64 {
+	return defaultReader.MustInt64Slice(key, sep)
+}
+
+// MustUint returns a uint value from environment variable, it panics if the variable is not set or invalid
+func MustUint(key string) uint {
+	return defaultReader.MustUint(key)
+}
+
+// MustUintSlice returns a uint slice from environment variable, it panics if the variable is not set or invalid
+func MustUintSlice(key, sep string) []uint {
+	return defaultReader.MustUintSlice(key, sep)
+}
+
+// MustUint8 returns a uint8 value from environment variable, it panics if the variable is not set or invalid
+func MustUint8(key string) uint8 {
+	return defaultReader.MustUint8(key)
+}
+
+// MustUint8Slice returns a uint8 slice from environment variable, it panics if the variable is not set or invalid
+func MustUint8Slice(key, sep string) []uint8 {
+	return defaultReader.MustUint8Slice(key, sep)
+}
+
+// MustUint16 returns a uint16 value from environment variable, it panics if the variable is not set or invalid
+func MustUint16(key string) uint16 {
+	return defaultReader.MustUint16(key)
+}
+
+// MustUint16Slice returns a uint16 slice from environment variable, it panics if the variable is not set or invalid
+func MustUint16Slice(key, sep string) []uint16 {
+	return defaultReader.MustUint16Slice(key, sep)
+}
+
+// MustUint32 returns a uint32 value from environment variable, it panics if the variable is not set or invalid
+func MustUint32(key string) uint32 {
+	return defaultReader.MustUint32(key)
+}
+
+// MustUint32Slice returns a uint32 slice from environment variable, it panics if the variable is not set or invalid
+func MustUint32Slice(key, sep string) []uint32 {
+	return defaultReader.MustUint32Slice(key, sep)
+}
+
+// MustUint64 returns a uint64 value from environment variable, it panics if the variable is not set or invalid
+func MustUint64(key string) uint64 {
+	return defaultReader.MustUint64(key)
+}
+
+// MustUint64Slice returns a uint64 slice from environment variable, it panics if the variable is not set or invalid
+func MustUint64Slice(key, sep string) []uint64 {
+	return defaultReader.MustUint64Slice(key, sep)
+}
+
+// MustBool returns a boolean value from environment variable, it panics if the variable is not set or invalid
+func MustBool(key string) bool {
+	return defaultReader.MustBool(key)
+}
+
+// MustBoolSlice returns a boolean slice from environment variable, it panics if the variable is not set or invalid
+func MustBoolSlice(key, sep string) []bool {
+	return defaultReader.MustBoolSlice(key, sep)
+}
+
+// MustFloat32 returns a float32 value from environment variable, it panics if the variable is not set or invalid
+func MustFloat32(key string) float32 {
+	return defaultReader.MustFloat32(key)
+}
+
+// MustFloat32Slice returns a float32 slice from environment variable, it panics if the variable is not set or invalid
+func MustFloat32Slice(key, sep string) []float32 {
+	return defaultReader.MustFloat32Slice(key, sep)
+}
+
+// MustFloat64 returns a float64 value from environment variable, it panics if the variable is not set or invalid
+func MustFloat64(key string) float64 {
+	return defaultReader.MustFloat64(key)
+}
+
+// MustFloat64Slice returns a float64 slice from environment variable, it panics if the variable is not set or invalid
+func MustFloat64Slice(key, sep string) []float64 {
+	return defaultReader.MustFloat64Slice(key, sep)
+}
+
+// MustBytes returns a byte slice value from environment variable, it panics if the variable is not set
+func MustBytes(key string) []byte {
+	return defaultReader.MustBytes(key)
+}
+
+// MustDuration returns a time.Duration value from environment variable, it panics if the variable is not set or invalid
+func MustDuration(key string, duration time.Duration) time.Duration {
+	return defaultReader.MustDuration(key, duration)
+}
+
+// MustDurationSlice returns a time.Duration slice from environment variable, it panics if the variable is not set or invalid
+func MustDurationSlice(key, sep string, duration time.Duration) []time.Duration {
+	return defaultReader.MustDurationSlice(key, sep, duration)
+}
+
+// MustBase64ToBytes converts a base64 string to a byte slice value from the environment variable, it panics if the variable is not set or invalid
+func MustBase64ToBytes(key string) []byte {
+	return defaultReader.MustBase64ToBytes(key)
+}
+
+// MustBase64ToString converts a base64 string to a string value from the environment variable, it panics if the variable is not set or invalid
+func MustBase64ToString(key string) string {
+	return defaultReader.MustBase64ToString(key)
+}
+
+// required returns a copy of the reader whose lookups fail with a *NotSetError when the environment variable is not set,
+// the Must functions resolve each variable once through it
+func (r *Reader) required() *Reader {
+	c := *r
+	c.mustBeSet = true
+	return &c
+}
+
+// MustString returns a string value from environment variable, it panics if the variable is not set
+func (r *Reader) MustString(key string) string {
+	return must(r.required().LookupString(key, ""))
+}
+
+// MustStringSlice returns a string slice from environment variable, it panics if the variable is not set
+func (r *Reader) MustStringSlice(key, sep string) []string {
+	return must(r.required().LookupStringSlice(key, sep, nil))
+}
+
+// MustInt returns a int value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustInt(key string) int {
+	return must(r.required().LookupInt(key, 0))
+}
+
+// MustIntSlice returns a int slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustIntSlice(key, sep string) []int {
+	return must(r.required().LookupIntSlice(key, sep, nil))
+}
+
+// MustInt8 returns a int8 value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustInt8(key string) int8 {
+	return must(r.required().LookupInt8(key, 0))
+}
+
+// MustInt8Slice returns a int8 slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustInt8Slice(key, sep string) []int8 {
+	return must(r.required().LookupInt8Slice(key, sep, nil))
+}
+
+// MustInt16 returns a int16 value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustInt16(key string) int16 {
+	return must(r.required().LookupInt16(key, 0))
+}
+
+// MustInt16Slice returns a int16 slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustInt16Slice(key, sep string) []int16 {
+	return must(r.required().LookupInt16Slice(key, sep, nil))
+}
+
+// MustInt32 returns a int32 value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustInt32(key string) int32 {
+	return must(r.required().LookupInt32(key, 0))
+}
+
+// MustInt32Slice returns a int32 slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustInt32Slice(key, sep string) []int32 {
+	return must(r.required().LookupInt32Slice(key, sep, nil))
+}
+
+// MustInt64 returns a int64 value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustInt64(key string) int64 {
+	return must(r.required().LookupInt64(key, 0))
+}
+
+// MustInt64Slice returns a int64 slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustInt64Slice(key, sep string) []int64 {
+	return must(r.required().LookupInt64Slice(key, sep, nil))
+}
+
+// MustUint returns a uint value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUint(key string) uint {
+	return must(r.required().LookupUint(key, 0))
+}
+
+// MustUintSlice returns a uint slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUintSlice(key, sep string) []uint {
+	return must(r.required().LookupUintSlice(key, sep, nil))
+}
+
+// MustUint8 returns a uint8 value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUint8(key string) uint8 {
+	return must(r.required().LookupUint8(key, 0))
+}
+
+// MustUint8Slice returns a uint8 slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUint8Slice(key, sep string) []uint8 {
+	return must(r.required().LookupUint8Slice(key, sep, nil))
+}
+
+// MustUint16 returns a uint16 value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUint16(key string) uint16 {
+	return must(r.required().LookupUint16(key, 0))
+}
+
+// MustUint16Slice returns a uint16 slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUint16Slice(key, sep string) []uint16 {
+	return must(r.required().LookupUint16Slice(key, sep, nil))
+}
+
+// MustUint32 returns a uint32 value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUint32(key string) uint32 {
+	return must(r.required().LookupUint32(key, 0))
+}
+
+// MustUint32Slice returns a uint32 slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUint32Slice(key, sep string) []uint32 {
+	return must(r.required().LookupUint32Slice(key, sep, nil))
+}
+
+// MustUint64 returns a uint64 value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUint64(key string) uint64 {
+	return must(r.required().LookupUint64(key, 0))
+}
+
+// MustUint64Slice returns a uint64 slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUint64Slice(key, sep string) []uint64 {
+	return must(r.required().LookupUint64Slice(key, sep, nil))
+}
+
+// MustBool returns a boolean value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustBool(key string) bool {
+	return must(r.required().LookupBool(key, false))
+}
+
+// MustBoolSlice returns a boolean slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustBoolSlice(key, sep string) []bool {
+	return must(r.required().LookupBoolSlice(key, sep, nil))
+}
+
+// MustFloat32 returns a float32 value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustFloat32(key string) float32 {
+	return must(r.required().LookupFloat32(key, 0))
+}
+
+// MustFloat32Slice returns a float32 slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustFloat32Slice(key, sep string) []float32 {
+	return must(r.required().LookupFloat32Slice(key, sep, nil))
+}
+
+// MustFloat64 returns a float64 value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustFloat64(key string) float64 {
+	return must(r.required().LookupFloat64(key, 0))
+}
+
+// MustFloat64Slice returns a float64 slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustFloat64Slice(key, sep string) []float64 {
+	return must(r.required().LookupFloat64Slice(key, sep, nil))
+}
+
+// MustBytes returns a byte slice value from environment variable, it panics if the variable is not set
+func (r *Reader) MustBytes(key string) []byte {
+	return must(r.required().LookupBytes(key, nil))
+}
+
+// MustDuration returns a time.Duration value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustDuration(key string, duration time.Duration) time.Duration {
+	return must(r.required().LookupDuration(key, 0, duration))
+}
+
+// MustDurationSlice returns a time.Duration slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustDurationSlice(key, sep string, duration time.Duration) []time.Duration {
+	return must(r.required().LookupDurationSlice(key, sep, nil, duration))
+}
+
+// MustBase64ToBytes converts a base64 string to a byte slice value from the environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustBase64ToBytes(key string) []byte {
+	return must(r.required().LookupBase64ToBytes(key, nil))
+}
+
+// MustBase64ToString converts a base64 string to a string value from the environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustBase64ToString(key string) string {
+	return must(r.required().LookupBase64ToString(key, ""))
+}
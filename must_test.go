@@ -0,0 +1,80 @@
+package env
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func expectPanic(t *testing.T, expected string, f func()) {
+	t.Helper()
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatalf("expected panic %q, got none", expected)
+		}
+		err, ok := r.(error)
+		if !ok {
+			t.Fatalf("expected panic with an error, actual %#v", r)
+		}
+		if err.Error() != expected {
+			t.Errorf("expected panic %q, actual %q", expected, err.Error())
+		}
+	}()
+
+	f()
+}
+
+func TestMustInt(t *testing.T) {
+	os.Setenv("MUST_INT2", "2")    //nolint:errcheck
+	os.Setenv("MUST_INT3", "três") //nolint:errcheck
+
+	if result := MustInt("MUST_INT2"); result != 2 {
+		t.Errorf("MustInt(\"MUST_INT2\"): expected %d, actual %d", 2, result)
+	}
+	expectPanic(t, "env: MUST_INT1 is not set", func() { MustInt("MUST_INT1") })
+	expectPanic(t, `env: parsing "três" from MUST_INT3 as int: strconv.Atoi: parsing "três": invalid syntax`, func() { MustInt("MUST_INT3") })
+}
+
+func TestMustFloat64Slice(t *testing.T) {
+	os.Setenv("MUST_FLOAT64_2", "1.5,2") //nolint:errcheck
+	os.Setenv("MUST_FLOAT64_3", "1.5,x") //nolint:errcheck
+
+	if result := MustFloat64Slice("MUST_FLOAT64_2", ","); !reflect.DeepEqual(result, []float64{1.5, 2}) {
+		t.Errorf("MustFloat64Slice(\"MUST_FLOAT64_2\", \",\"): expected %#v, actual %#v", []float64{1.5, 2}, result)
+	}
+	expectPanic(t, "env: MUST_FLOAT64_1 is not set", func() { MustFloat64Slice("MUST_FLOAT64_1", ",") })
+	expectPanic(t, `env: parsing "1.5,x" from MUST_FLOAT64_3 as []float64: strconv.ParseFloat: parsing "x": invalid syntax`, func() { MustFloat64Slice("MUST_FLOAT64_3", ",") })
+}
+
+func TestMustString(t *testing.T) {
+	os.Setenv("MUST_STRING2", "") //nolint:errcheck
+
+	if result := MustString("MUST_STRING2"); result != "" {
+		t.Errorf("MustString(\"MUST_STRING2\"): expected %q, actual %q", "", result)
+	}
+	expectPanic(t, "env: MUST_STRING1 is not set", func() { MustString("MUST_STRING1") })
+}
+
+func TestMustDuration(t *testing.T) {
+	os.Setenv("MUST_DURATION2", "10") //nolint:errcheck
+
+	if result := MustDuration("MUST_DURATION2", time.Second); result != 10*time.Second {
+		t.Errorf("MustDuration(\"MUST_DURATION2\", %v): expected %v, actual %v", time.Second, 10*time.Second, result)
+	}
+	expectPanic(t, "env: MUST_DURATION1 is not set", func() { MustDuration("MUST_DURATION1", time.Second) })
+}
+
+func TestMustBase64ToBytes(t *testing.T) {
+	os.Setenv("MUST_BASE64-2", "SGVsbG8gV29ybGQgMg==") //nolint:errcheck
+	os.Setenv("MUST_BASE64-3", "invalid-base64-value") //nolint:errcheck
+
+	if result := MustBase64ToBytes("MUST_BASE64-2"); !bytes.Equal(result, []byte("Hello World 2")) {
+		t.Errorf("MustBase64ToBytes(\"MUST_BASE64-2\"): expected %#v, actual %#v", []byte("Hello World 2"), result)
+	}
+	expectPanic(t, "env: MUST_BASE64-1 is not set", func() { MustBase64ToBytes("MUST_BASE64-1") })
+	expectPanic(t, `env: parsing "invalid-base64-value" from MUST_BASE64-3 as base64: illegal base64 data at input byte 7`, func() { MustBase64ToBytes("MUST_BASE64-3") })
+}
@@ -0,0 +1,376 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strconv"
+)
+
+// HostPort is a network address split into host and port, the host may be empty, ex: :8080
+type HostPort struct {
+	Host string
+	Port uint16
+}
+
+// String returns the address in the host:port form
+func (h HostPort) String() string {
+	return net.JoinHostPort(h.Host, strconv.FormatUint(uint64(h.Port), 10))
+}
+
+// ParseHostPort parses a host:port address, the port must be a number between 0 and 65535
+func ParseHostPort(s string) (HostPort, error) {
+	host, port, err := net.SplitHostPort(s)
+	if err != nil {
+		return HostPort{}, err
+	}
+
+	result, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return HostPort{}, fmt.Errorf("invalid port %q: %w", port, err)
+	}
+
+	return HostPort{Host: host, Port: uint16(result)}, nil
+}
+
+// GetURL returns a *url.URL with a scheme and a host from environment variable or the default value
+func GetURL(key string, defaultValue *url.URL) *url.URL {
+	return defaultReader.GetURL(key, defaultValue)
+}
+
+// GetHostPort returns a HostPort from a host:port value from environment variable or the default value
+func GetHostPort(key string, defaultValue HostPort) HostPort {
+	return defaultReader.GetHostPort(key, defaultValue)
+}
+
+// GetIP returns a net.IP from environment variable or the default value
+func GetIP(key string, defaultValue net.IP) net.IP {
+	return defaultReader.GetIP(key, defaultValue)
+}
+
+// GetIPNet returns a *net.IPNet from a CIDR from environment variable or the default value
+func GetIPNet(key string, defaultValue *net.IPNet) *net.IPNet {
+	return defaultReader.GetIPNet(key, defaultValue)
+}
+
+// GetAddr returns a netip.Addr from environment variable or the default value
+func GetAddr(key string, defaultValue netip.Addr) netip.Addr {
+	return defaultReader.GetAddr(key, defaultValue)
+}
+
+// GetPrefix returns a netip.Prefix from a CIDR from environment variable or the default value
+func GetPrefix(key string, defaultValue netip.Prefix) netip.Prefix {
+	return defaultReader.GetPrefix(key, defaultValue)
+}
+
+// GetIPSlice returns a net.IP slice from environment variable or the default value
+func GetIPSlice(key, sep string, defaultValue []net.IP) []net.IP {
+	return defaultReader.GetIPSlice(key, sep, defaultValue)
+}
+
+// GetIPNetSlice returns a *net.IPNet slice from CIDRs from environment variable or the default value
+func GetIPNetSlice(key, sep string, defaultValue []*net.IPNet) []*net.IPNet {
+	return defaultReader.GetIPNetSlice(key, sep, defaultValue)
+}
+
+// GetAddrSlice returns a netip.Addr slice from environment variable or the default value
+func GetAddrSlice(key, sep string, defaultValue []netip.Addr) []netip.Addr {
+	return defaultReader.GetAddrSlice(key, sep, defaultValue)
+}
+
+// GetPrefixSlice returns a netip.Prefix slice from CIDRs from environment variable or the default value
+func GetPrefixSlice(key, sep string, defaultValue []netip.Prefix) []netip.Prefix {
+	return defaultReader.GetPrefixSlice(key, sep, defaultValue)
+}
+
+// LookupURL returns a *url.URL with a scheme and a host from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupURL(key string, defaultValue *url.URL) (*url.URL, error) {
+	return defaultReader.LookupURL(key, defaultValue)
+}
+
+// LookupHostPort returns a HostPort from a host:port value from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupHostPort(key string, defaultValue HostPort) (HostPort, error) {
+	return defaultReader.LookupHostPort(key, defaultValue)
+}
+
+// LookupIP returns a net.IP from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupIP(key string, defaultValue net.IP) (net.IP, error) {
+	return defaultReader.LookupIP(key, defaultValue)
+}
+
+// LookupIPNet returns a *net.IPNet from a CIDR from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupIPNet(key string, defaultValue *net.IPNet) (*net.IPNet, error) {
+	return defaultReader.LookupIPNet(key, defaultValue)
+}
+
+// LookupAddr returns a netip.Addr from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupAddr(key string, defaultValue netip.Addr) (netip.Addr, error) {
+	return defaultReader.LookupAddr(key, defaultValue)
+}
+
+// LookupPrefix returns a netip.Prefix from a CIDR from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupPrefix(key string, defaultValue netip.Prefix) (netip.Prefix, error) {
+	return defaultReader.LookupPrefix(key, defaultValue)
+}
+
+// LookupIPSlice returns a net.IP slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupIPSlice(key, sep string, defaultValue []net.IP) ([]net.IP, error) {
+	return defaultReader.LookupIPSlice(key, sep, defaultValue)
+}
+
+// LookupIPNetSlice returns a *net.IPNet slice from CIDRs from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupIPNetSlice(key, sep string, defaultValue []*net.IPNet) ([]*net.IPNet, error) {
+	return defaultReader.LookupIPNetSlice(key, sep, defaultValue)
+}
+
+// LookupAddrSlice returns a netip.Addr slice from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupAddrSlice(key, sep string, defaultValue []netip.Addr) ([]netip.Addr, error) {
+	return defaultReader.LookupAddrSlice(key, sep, defaultValue)
+}
+
+// LookupPrefixSlice returns a netip.Prefix slice from CIDRs from environment variable or the default value, with a *ParseError if any item is invalid
+func LookupPrefixSlice(key, sep string, defaultValue []netip.Prefix) ([]netip.Prefix, error) {
+	return defaultReader.LookupPrefixSlice(key, sep, defaultValue)
+}
+
+// MustURL returns a *url.URL with a scheme and a host from environment variable, it panics if the variable is not set or invalid
+func MustURL(key string) *url.URL {
+	return defaultReader.MustURL(key)
+}
+
+// MustHostPort returns a HostPort from a host:port value from environment variable, it panics if the variable is not set or invalid
+func MustHostPort(key string) HostPort {
+	return defaultReader.MustHostPort(key)
+}
+
+// MustIP returns a net.IP from environment variable, it panics if the variable is not set or invalid
+func MustIP(key string) net.IP {
+	return defaultReader.MustIP(key)
+}
+
+// MustIPNet returns a *net.IPNet from a CIDR from environment variable, it panics if the variable is not set or invalid
+func MustIPNet(key string) *net.IPNet {
+	return defaultReader.MustIPNet(key)
+}
+
+// MustAddr returns a netip.Addr from environment variable, it panics if the variable is not set or invalid
+func MustAddr(key string) netip.Addr {
+	return defaultReader.MustAddr(key)
+}
+
+// MustPrefix returns a netip.Prefix from a CIDR from environment variable, it panics if the variable is not set or invalid
+func MustPrefix(key string) netip.Prefix {
+	return defaultReader.MustPrefix(key)
+}
+
+// MustIPSlice returns a net.IP slice from environment variable, it panics if the variable is not set or invalid
+func MustIPSlice(key, sep string) []net.IP {
+	return defaultReader.MustIPSlice(key, sep)
+}
+
+// MustIPNetSlice returns a *net.IPNet slice from CIDRs from environment variable, it panics if the variable is not set or invalid
+func MustIPNetSlice(key, sep string) []*net.IPNet {
+	return defaultReader.MustIPNetSlice(key, sep)
+}
+
+// MustAddrSlice returns a netip.Addr slice from environment variable, it panics if the variable is not set or invalid
+func MustAddrSlice(key, sep string) []netip.Addr {
+	return defaultReader.MustAddrSlice(key, sep)
+}
+
+// MustPrefixSlice returns a netip.Prefix slice from CIDRs from environment variable, it panics if the variable is not set or invalid
+func MustPrefixSlice(key, sep string) []netip.Prefix {
+	return defaultReader.MustPrefixSlice(key, sep)
+}
+
+// GetURL returns a *url.URL with a scheme and a host from environment variable or the default value
+func (r *Reader) GetURL(key string, defaultValue *url.URL) *url.URL {
+	value, _ := r.LookupURL(key, defaultValue)
+	return value
+}
+
+// GetHostPort returns a HostPort from a host:port value from environment variable or the default value
+func (r *Reader) GetHostPort(key string, defaultValue HostPort) HostPort {
+	value, _ := r.LookupHostPort(key, defaultValue)
+	return value
+}
+
+// GetIP returns a net.IP from environment variable or the default value
+func (r *Reader) GetIP(key string, defaultValue net.IP) net.IP {
+	value, _ := r.LookupIP(key, defaultValue)
+	return value
+}
+
+// GetIPNet returns a *net.IPNet from a CIDR from environment variable or the default value
+func (r *Reader) GetIPNet(key string, defaultValue *net.IPNet) *net.IPNet {
+	value, _ := r.LookupIPNet(key, defaultValue)
+	return value
+}
+
+// GetAddr returns a netip.Addr from environment variable or the default value
+func (r *Reader) GetAddr(key string, defaultValue netip.Addr) netip.Addr {
+	value, _ := r.LookupAddr(key, defaultValue)
+	return value
+}
+
+// GetPrefix returns a netip.Prefix from a CIDR from environment variable or the default value
+func (r *Reader) GetPrefix(key string, defaultValue netip.Prefix) netip.Prefix {
+	value, _ := r.LookupPrefix(key, defaultValue)
+	return value
+}
+
+// GetIPSlice returns a net.IP slice from environment variable or the default value
+func (r *Reader) GetIPSlice(key, sep string, defaultValue []net.IP) []net.IP {
+	value, _ := r.LookupIPSlice(key, sep, defaultValue)
+	return value
+}
+
+// GetIPNetSlice returns a *net.IPNet slice from CIDRs from environment variable or the default value
+func (r *Reader) GetIPNetSlice(key, sep string, defaultValue []*net.IPNet) []*net.IPNet {
+	value, _ := r.LookupIPNetSlice(key, sep, defaultValue)
+	return value
+}
+
+// GetAddrSlice returns a netip.Addr slice from environment variable or the default value
+func (r *Reader) GetAddrSlice(key, sep string, defaultValue []netip.Addr) []netip.Addr {
+	value, _ := r.LookupAddrSlice(key, sep, defaultValue)
+	return value
+}
+
+// GetPrefixSlice returns a netip.Prefix slice from CIDRs from environment variable or the default value
+func (r *Reader) GetPrefixSlice(key, sep string, defaultValue []netip.Prefix) []netip.Prefix {
+	value, _ := r.LookupPrefixSlice(key, sep, defaultValue)
+	return value
+}
+
+// LookupURL returns a *url.URL with a scheme and a host from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupURL(key string, defaultValue *url.URL) (*url.URL, error) {
+	return lookupWith(r, key, defaultValue, "*url.URL", parseURL)
+}
+
+// LookupHostPort returns a HostPort from a host:port value from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupHostPort(key string, defaultValue HostPort) (HostPort, error) {
+	return lookupWith(r, key, defaultValue, "host:port", ParseHostPort)
+}
+
+// LookupIP returns a net.IP from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupIP(key string, defaultValue net.IP) (net.IP, error) {
+	return lookupWith(r, key, defaultValue, "net.IP", parseIP)
+}
+
+// LookupIPNet returns a *net.IPNet from a CIDR from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupIPNet(key string, defaultValue *net.IPNet) (*net.IPNet, error) {
+	return lookupWith(r, key, defaultValue, "*net.IPNet", parseIPNet)
+}
+
+// LookupAddr returns a netip.Addr from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupAddr(key string, defaultValue netip.Addr) (netip.Addr, error) {
+	return lookupWith(r, key, defaultValue, "netip.Addr", netip.ParseAddr)
+}
+
+// LookupPrefix returns a netip.Prefix from a CIDR from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupPrefix(key string, defaultValue netip.Prefix) (netip.Prefix, error) {
+	return lookupWith(r, key, defaultValue, "netip.Prefix", netip.ParsePrefix)
+}
+
+// LookupIPSlice returns a net.IP slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupIPSlice(key, sep string, defaultValue []net.IP) ([]net.IP, error) {
+	return lookupSliceWith(r, key, sep, defaultValue, "[]net.IP", parseIP)
+}
+
+// LookupIPNetSlice returns a *net.IPNet slice from CIDRs from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupIPNetSlice(key, sep string, defaultValue []*net.IPNet) ([]*net.IPNet, error) {
+	return lookupSliceWith(r, key, sep, defaultValue, "[]*net.IPNet", parseIPNet)
+}
+
+// LookupAddrSlice returns a netip.Addr slice from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupAddrSlice(key, sep string, defaultValue []netip.Addr) ([]netip.Addr, error) {
+	return lookupSliceWith(r, key, sep, defaultValue, "[]netip.Addr", netip.ParseAddr)
+}
+
+// LookupPrefixSlice returns a netip.Prefix slice from CIDRs from environment variable or the default value, with a *ParseError if any item is invalid
+func (r *Reader) LookupPrefixSlice(key, sep string, defaultValue []netip.Prefix) ([]netip.Prefix, error) {
+	return lookupSliceWith(r, key, sep, defaultValue, "[]netip.Prefix", netip.ParsePrefix)
+}
+
+// MustURL returns a *url.URL with a scheme and a host from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustURL(key string) *url.URL {
+	return must(r.required().LookupURL(key, nil))
+}
+
+// MustHostPort returns a HostPort from a host:port value from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustHostPort(key string) HostPort {
+	return must(r.required().LookupHostPort(key, HostPort{}))
+}
+
+// MustIP returns a net.IP from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustIP(key string) net.IP {
+	return must(r.required().LookupIP(key, nil))
+}
+
+// MustIPNet returns a *net.IPNet from a CIDR from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustIPNet(key string) *net.IPNet {
+	return must(r.required().LookupIPNet(key, nil))
+}
+
+// MustAddr returns a netip.Addr from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustAddr(key string) netip.Addr {
+	return must(r.required().LookupAddr(key, netip.Addr{}))
+}
+
+// MustPrefix returns a netip.Prefix from a CIDR from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustPrefix(key string) netip.Prefix {
+	return must(r.required().LookupPrefix(key, netip.Prefix{}))
+}
+
+// MustIPSlice returns a net.IP slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustIPSlice(key, sep string) []net.IP {
+	return must(r.required().LookupIPSlice(key, sep, nil))
+}
+
+// MustIPNetSlice returns a *net.IPNet slice from CIDRs from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustIPNetSlice(key, sep string) []*net.IPNet {
+	return must(r.required().LookupIPNetSlice(key, sep, nil))
+}
+
+// MustAddrSlice returns a netip.Addr slice from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustAddrSlice(key, sep string) []netip.Addr {
+	return must(r.required().LookupAddrSlice(key, sep, nil))
+}
+
+// MustPrefixSlice returns a netip.Prefix slice from CIDRs from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustPrefixSlice(key, sep string) []netip.Prefix {
+	return must(r.required().LookupPrefixSlice(key, sep, nil))
+}
+
+// parseURL parses an absolute URL, which must have a scheme and a host
+func parseURL(s string) (*url.URL, error) {
+	result, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	if result.Scheme == "" {
+		return nil, errors.New("missing scheme")
+	}
+	if result.Host == "" {
+		return nil, errors.New("missing host")
+	}
+
+	return result, nil
+}
+
+func parseIP(s string) (net.IP, error) {
+	result := net.ParseIP(s)
+	if result == nil {
+		return nil, fmt.Errorf("invalid IP address %q", s)
+	}
+
+	return result, nil
+}
+
+func parseIPNet(s string) (*net.IPNet, error) {
+	_, result, err := net.ParseCIDR(s)
+	return result, err
+}
@@ -0,0 +1,99 @@
+package env
+
+// Optional is a value read from environment variable that tells an unset variable apart from a zero value
+type Optional[T any] struct {
+	Value T
+	IsSet bool
+}
+
+// Ptr returns a pointer to the value or nil if the variable is not set
+func (o Optional[T]) Ptr() *T {
+	if !o.IsSet {
+		return nil
+	}
+
+	value := o.Value
+	return &value
+}
+
+// Or returns the value or fallback if the variable is not set
+func (o Optional[T]) Or(fallback T) T {
+	if !o.IsSet {
+		return fallback
+	}
+
+	return o.Value
+}
+
+// GetOptional returns an Optional T from environment variable, it is not set if the variable is not set or invalid
+func GetOptional[T Value](key string) Optional[T] {
+	return GetOptionalFrom[T](defaultReader, key)
+}
+
+// GetOptionalSlice returns an Optional T slice from environment variable, it is not set if the variable is not set or invalid
+func GetOptionalSlice[T Value](key, sep string) Optional[[]T] {
+	return GetOptionalSliceFrom[T](defaultReader, key, sep)
+}
+
+// GetPtr returns a pointer to a T value from environment variable, nil if the variable is not set or invalid
+func GetPtr[T Value](key string) *T {
+	return GetPtrFrom[T](defaultReader, key)
+}
+
+// LookupOptional returns an Optional T from environment variable, with a *ParseError if the value is invalid
+func LookupOptional[T Value](key string) (Optional[T], error) {
+	return LookupOptionalFrom[T](defaultReader, key)
+}
+
+// LookupOptionalSlice returns an Optional T slice from environment variable, with a *ParseError if any item is invalid
+func LookupOptionalSlice[T Value](key, sep string) (Optional[[]T], error) {
+	return LookupOptionalSliceFrom[T](defaultReader, key, sep)
+}
+
+// LookupOptionalFunc returns an Optional value converted by parse from environment variable, with a *ParseError if the value is invalid.
+// It covers the types without a generic accessor, ex: LookupOptionalFunc("TZ", time.LoadLocation)
+func LookupOptionalFunc[T any](key string, parse func(string) (T, error)) (Optional[T], error) {
+	return LookupOptionalFuncFrom(defaultReader, key, parse)
+}
+
+// GetOptionalFrom returns an Optional T from the reader's environment variable, see GetOptional
+func GetOptionalFrom[T Value](r *Reader, key string) Optional[T] {
+	value, _ := LookupOptionalFrom[T](r, key)
+	return value
+}
+
+// GetOptionalSliceFrom returns an Optional T slice from the reader's environment variable, see GetOptionalSlice
+func GetOptionalSliceFrom[T Value](r *Reader, key, sep string) Optional[[]T] {
+	value, _ := LookupOptionalSliceFrom[T](r, key, sep)
+	return value
+}
+
+// GetPtrFrom returns a pointer to a T value from the reader's environment variable, see GetPtr
+func GetPtrFrom[T Value](r *Reader, key string) *T {
+	return GetOptionalFrom[T](r, key).Ptr()
+}
+
+// LookupOptionalFrom returns an Optional T from the reader's environment variable, see LookupOptional
+func LookupOptionalFrom[T Value](r *Reader, key string) (Optional[T], error) {
+	return lookupOptional(r, key, typeName[T](), parser[T](r))
+}
+
+// LookupOptionalSliceFrom returns an Optional T slice from the reader's environment variable, see LookupOptionalSlice
+func LookupOptionalSliceFrom[T Value](r *Reader, key, sep string) (Optional[[]T], error) {
+	return lookupOptional(r, key, typeName[[]T](), sliceParser(r, sep, parser[T](r)))
+}
+
+// LookupOptionalFuncFrom returns an Optional value converted by parse from the reader's environment variable, see LookupOptionalFunc
+func LookupOptionalFuncFrom[T any](r *Reader, key string, parse func(string) (T, error)) (Optional[T], error) {
+	return lookupOptional(r, key, typeName[T](), parse)
+}
+
+// lookupOptional resolves the environment variable once, the Optional is set if the variable is set and valid
+func lookupOptional[T any](r *Reader, key, typ string, parse func(string) (T, error)) (Optional[T], error) {
+	value, ok, err := lookupValue(r, key, typ, parse)
+	if err != nil || !ok {
+		return Optional[T]{}, err
+	}
+
+	return Optional[T]{Value: value, IsSet: true}, nil
+}
@@ -0,0 +1,72 @@
+package env
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReaderGetOptional(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"DEBUG":   "false",
+		"WORKERS": "0",
+		"HOSTS":   "a,b",
+		"INVALID": "x",
+		"TZ":      "UTC",
+		"X_PORT":  "9",
+	})
+
+	var tests = []struct {
+		kind          string
+		key           string
+		expectedValue Optional[bool]
+	}{
+		{"test-set-false", "DEBUG", Optional[bool]{Value: false, IsSet: true}},
+		{"test-unset", "UNSET", Optional[bool]{}},
+		{"test-invalid", "INVALID", Optional[bool]{}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			if result := GetOptionalFrom[bool](r, tt.key); result != tt.expectedValue {
+				t.Errorf("GetOptionalFrom[bool](r, \"%s\"): expected %+v, actual %+v", tt.key, tt.expectedValue, result)
+			}
+		})
+	}
+
+	if result := GetPtrFrom[int](r, "WORKERS"); result == nil || *result != 0 {
+		t.Errorf("GetPtrFrom[int](r, \"WORKERS\"): expected a pointer to 0, actual %v", result)
+	}
+	if result := GetPtrFrom[int](r, "UNSET"); result != nil {
+		t.Errorf("GetPtrFrom[int](r, \"UNSET\"): expected nil, actual %v", *result)
+	}
+	if result := GetOptionalFrom[int](r, "UNSET").Or(4); result != 4 {
+		t.Errorf("GetOptionalFrom[int](r, \"UNSET\").Or(4): expected %d, actual %d", 4, result)
+	}
+	if result := GetOptionalSliceFrom[string](r, "HOSTS", ","); !result.IsSet || !reflect.DeepEqual(result.Value, []string{"a", "b"}) {
+		t.Errorf("GetOptionalSliceFrom[string](r, \"HOSTS\", \",\"): unexpected result %+v", result)
+	}
+
+	var parseErr *ParseError
+	if result, err := LookupOptionalFrom[int](r, "INVALID"); result.IsSet || !errors.As(err, &parseErr) {
+		t.Errorf("LookupOptionalFrom[int](r, \"INVALID\"): expected an unset value with *ParseError, actual %+v, %v", result, err)
+	}
+	if result, err := LookupOptionalFuncFrom(r, "TZ", time.LoadLocation); err != nil || !result.IsSet || result.Value != time.UTC {
+		t.Errorf("LookupOptionalFuncFrom(r, \"TZ\", time.LoadLocation): unexpected result %+v, %v", result, err)
+	}
+	if result, err := LookupOptionalFrom[int](r.WithPrefix("X_"), "PORT"); err != nil || result != (Optional[int]{Value: 9, IsSet: true}) {
+		t.Errorf("LookupOptionalFrom[int](r.WithPrefix(\"X_\"), \"PORT\"): unexpected result %+v, %v", result, err)
+	}
+	if result, err := LookupOptionalSliceFrom[int](r, "HOSTS", ","); result.IsSet || !errors.As(err, &parseErr) || parseErr.Type != "[]int" {
+		t.Errorf("LookupOptionalSliceFrom[int](r, \"HOSTS\", \",\"): expected an unset value with *ParseError, actual %+v, %v", result, err)
+	}
+	if result, err := LookupOptionalFuncFrom(r, "UNSET", time.LoadLocation); err != nil || result.IsSet {
+		t.Errorf("LookupOptionalFuncFrom(r, \"UNSET\", time.LoadLocation): unexpected result %+v, %v", result, err)
+	}
+}
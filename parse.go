@@ -0,0 +1,112 @@
+package env
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Value is the set of types supported by the generic functions
+type Value interface {
+	string | []byte | bool |
+		int | int8 | int16 | int32 | int64 |
+		uint | uint8 | uint16 | uint32 | uint64 |
+		float32 | float64 |
+		time.Duration
+}
+
+// parse converts s to T, integers are parsed with the options and time.Duration values with ParseDuration
+func parse[T Value](s string, options IntOptions) (T, error) {
+	var value T
+	var err error
+
+	switch p := any(&value).(type) {
+	case *string:
+		*p = s
+	case *[]byte:
+		*p = []byte(s)
+	case *bool:
+		*p, err = strconv.ParseBool(s)
+	case *int:
+		if options == (IntOptions{}) {
+			*p, err = strconv.Atoi(s)
+			break
+		}
+		var result int64
+		result, err = options.parseInt(s, 0)
+		*p = int(result)
+	case *int8:
+		var result int64
+		result, err = options.parseInt(s, 8)
+		*p = int8(result)
+	case *int16:
+		var result int64
+		result, err = options.parseInt(s, 16)
+		*p = int16(result)
+	case *int32:
+		var result int64
+		result, err = options.parseInt(s, 32)
+		*p = int32(result)
+	case *int64:
+		*p, err = options.parseInt(s, 64)
+	case *uint:
+		var result uint64
+		result, err = options.parseUint(s, 0)
+		*p = uint(result)
+	case *uint8:
+		var result uint64
+		result, err = options.parseUint(s, 8)
+		*p = uint8(result)
+	case *uint16:
+		var result uint64
+		result, err = options.parseUint(s, 16)
+		*p = uint16(result)
+	case *uint32:
+		var result uint64
+		result, err = options.parseUint(s, 32)
+		*p = uint32(result)
+	case *uint64:
+		*p, err = options.parseUint(s, 64)
+	case *float32:
+		var result float64
+		result, err = strconv.ParseFloat(s, 32)
+		*p = float32(result)
+	case *float64:
+		*p, err = strconv.ParseFloat(s, 64)
+	case *time.Duration:
+		*p, err = ParseDuration(s)
+	}
+
+	return value, err
+}
+
+// parser returns the function converting strings to T with the reader's IntOptions and RangePolicy
+func parser[T Value](r *Reader) func(string) (T, error) {
+	return func(s string) (T, error) {
+		value, err := parse[T](s, r.intOptions)
+		switch any(value).(type) {
+		case uint, uint8, uint16, uint32, uint64:
+			if err != nil && r.clampsNegative(s) {
+				var zero T
+				return zero, nil
+			}
+		}
+		if err == nil || r.rangeError(err) != nil {
+			return value, err
+		}
+
+		switch p := any(&value).(type) {
+		case *float32:
+			*p = float32(clampFloat(float64(*p), 32))
+		case *float64:
+			*p = clampFloat(*p, 64)
+		}
+		return value, nil
+	}
+}
+
+// typeName returns the name of T used by ParseError, ex: int8 or []float64
+func typeName[T any]() string {
+	var value T
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,482 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrOutOfRange is wrapped by the errors of the InRange functions when a value is outside of the accepted range
+var ErrOutOfRange = errors.New("value out of range")
+
+// Number is the set of numeric types supported by the InRange functions
+type Number interface {
+	int | int8 | int16 | int32 | int64 |
+		uint | uint8 | uint16 | uint32 | uint64 |
+		float32 | float64
+}
+
+// RangePolicy defines how the integer and float functions handle values out of range, NaN is never clamped
+type RangePolicy int
+
+const (
+	// RangeReject rejects values that do not fit the type, or the min and max of the InRange functions, with a *ParseError
+	RangeReject RangePolicy = iota
+	// RangeClamp clamps values to the bounds of the type, negative integers read as 0 by unsigned types, or to the min and max of the InRange functions
+	RangeClamp
+)
+
+// WithRangePolicy returns a reader that handles numeric values out of range with the policy
+func WithRangePolicy(policy RangePolicy) *Reader {
+	return defaultReader.WithRangePolicy(policy)
+}
+
+// WithRangePolicy returns a copy of the reader that handles numeric values out of range with the policy
+func (r *Reader) WithRangePolicy(policy RangePolicy) *Reader {
+	c := *r
+	c.rangePolicy = policy
+	return &c
+}
+
+// GetInRange returns a T value between min and max inclusive from environment variable or the default value
+func GetInRange[T Number](key string, minValue, maxValue, defaultValue T) T {
+	return GetInRangeFrom(defaultReader, key, minValue, maxValue, defaultValue)
+}
+
+// LookupInRange returns a T value between min and max inclusive from environment variable or the default value,
+// with a *ParseError wrapping ErrOutOfRange if the value is outside of the range and the policy is RangeReject
+func LookupInRange[T Number](key string, minValue, maxValue, defaultValue T) (T, error) {
+	return LookupInRangeFrom(defaultReader, key, minValue, maxValue, defaultValue)
+}
+
+// MustInRange returns a T value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func MustInRange[T Number](key string, minValue, maxValue T) T {
+	return MustInRangeFrom(defaultReader, key, minValue, maxValue)
+}
+
+// GetInRangeFrom returns a T value between min and max inclusive from the reader's environment variable or the default value, see GetInRange
+func GetInRangeFrom[T Number](r *Reader, key string, minValue, maxValue, defaultValue T) T {
+	value, _ := LookupInRangeFrom(r, key, minValue, maxValue, defaultValue)
+	return value
+}
+
+// LookupInRangeFrom returns a T value between min and max inclusive from the reader's environment variable or the default value, see LookupInRange
+func LookupInRangeFrom[T Number](r *Reader, key string, minValue, maxValue, defaultValue T) (T, error) {
+	parse := parser[T](r)
+	return lookupWith(r, key, defaultValue, typeName[T](), func(s string) (T, error) {
+		value, err := parse(s)
+		if err != nil {
+			return value, err
+		}
+
+		switch {
+		case value >= minValue && value <= maxValue:
+			return value, nil
+		case r.rangePolicy == RangeClamp && value < minValue:
+			return minValue, nil
+		case r.rangePolicy == RangeClamp && value > maxValue:
+			return maxValue, nil
+		}
+
+		return value, fmt.Errorf("%w: %v is not between %v and %v", ErrOutOfRange, value, minValue, maxValue)
+	})
+}
+
+// MustInRangeFrom returns a T value between min and max inclusive from the reader's environment variable, it panics if the variable is not set or invalid
+func MustInRangeFrom[T Number](r *Reader, key string, minValue, maxValue T) T {
+	var zero T
+	return must(LookupInRangeFrom(r.required(), key, minValue, maxValue, zero))
+}
+
+// GetIntInRange returns a int value between min and max inclusive from environment variable or the default value
+func GetIntInRange(key string, minValue, maxValue, defaultValue int) int {
+	return defaultReader.GetIntInRange(key, minValue, maxValue, defaultValue)
+}
+
+// GetInt8InRange returns a int8 value between min and max inclusive from environment variable or the default value
+func GetInt8InRange(key string, minValue, maxValue, defaultValue int8) int8 {
+	return defaultReader.GetInt8InRange(key, minValue, maxValue, defaultValue)
+}
+
+// GetInt16InRange returns a int16 value between min and max inclusive from environment variable or the default value
+func GetInt16InRange(key string, minValue, maxValue, defaultValue int16) int16 {
+	return defaultReader.GetInt16InRange(key, minValue, maxValue, defaultValue)
+}
+
+// GetInt32InRange returns a int32 value between min and max inclusive from environment variable or the default value
+func GetInt32InRange(key string, minValue, maxValue, defaultValue int32) int32 {
+	return defaultReader.GetInt32InRange(key, minValue, maxValue, defaultValue)
+}
+
+// GetInt64InRange returns a int64 value between min and max inclusive from environment variable or the default value
+func GetInt64InRange(key string, minValue, maxValue, defaultValue int64) int64 {
+	return defaultReader.GetInt64InRange(key, minValue, maxValue, defaultValue)
+}
+
+// GetUintInRange returns a uint value between min and max inclusive from environment variable or the default value
+func GetUintInRange(key string, minValue, maxValue, defaultValue uint) uint {
+	return defaultReader.GetUintInRange(key, minValue, maxValue, defaultValue)
+}
+
+// GetUint8InRange returns a uint8 value between min and max inclusive from environment variable or the default value
+func GetUint8InRange(key string, minValue, maxValue, defaultValue uint8) uint8 {
+	return defaultReader.GetUint8InRange(key, minValue, maxValue, defaultValue)
+}
+
+// GetUint16InRange returns a uint16 value between min and max inclusive from environment variable or the default value
+func GetUint16InRange(key string, minValue, maxValue, defaultValue uint16) uint16 {
+	return defaultReader.GetUint16InRange(key, minValue, maxValue, defaultValue)
+}
+
+// GetUint32InRange returns a uint32 value between min and max inclusive from environment variable or the default value
+func GetUint32InRange(key string, minValue, maxValue, defaultValue uint32) uint32 {
+	return defaultReader.GetUint32InRange(key, minValue, maxValue, defaultValue)
+}
+
+// GetUint64InRange returns a uint64 value between min and max inclusive from environment variable or the default value
+func GetUint64InRange(key string, minValue, maxValue, defaultValue uint64) uint64 {
+	return defaultReader.GetUint64InRange(key, minValue, maxValue, defaultValue)
+}
+
+// GetFloat32InRange returns a float32 value between min and max inclusive from environment variable or the default value
+func GetFloat32InRange(key string, minValue, maxValue, defaultValue float32) float32 {
+	return defaultReader.GetFloat32InRange(key, minValue, maxValue, defaultValue)
+}
+
+// GetFloat64InRange returns a float64 value between min and max inclusive from environment variable or the default value
+func GetFloat64InRange(key string, minValue, maxValue, defaultValue float64) float64 {
+	return defaultReader.GetFloat64InRange(key, minValue, maxValue, defaultValue)
+}
+
+// LookupIntInRange returns a int value between min and max inclusive from environment variable or the default value, see LookupInRange
+func LookupIntInRange(key string, minValue, maxValue, defaultValue int) (int, error) {
+	return defaultReader.LookupIntInRange(key, minValue, maxValue, defaultValue)
+}
+
+// LookupInt8InRange returns a int8 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func LookupInt8InRange(key string, minValue, maxValue, defaultValue int8) (int8, error) {
+	return defaultReader.LookupInt8InRange(key, minValue, maxValue, defaultValue)
+}
+
+// LookupInt16InRange returns a int16 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func LookupInt16InRange(key string, minValue, maxValue, defaultValue int16) (int16, error) {
+	return defaultReader.LookupInt16InRange(key, minValue, maxValue, defaultValue)
+}
+
+// LookupInt32InRange returns a int32 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func LookupInt32InRange(key string, minValue, maxValue, defaultValue int32) (int32, error) {
+	return defaultReader.LookupInt32InRange(key, minValue, maxValue, defaultValue)
+}
+
+// LookupInt64InRange returns a int64 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func LookupInt64InRange(key string, minValue, maxValue, defaultValue int64) (int64, error) {
+	return defaultReader.LookupInt64InRange(key, minValue, maxValue, defaultValue)
+}
+
+// LookupUintInRange returns a uint value between min and max inclusive from environment variable or the default value, see LookupInRange
+func LookupUintInRange(key string, minValue, maxValue, defaultValue uint) (uint, error) {
+	return defaultReader.LookupUintInRange(key, minValue, maxValue, defaultValue)
+}
+
+// LookupUint8InRange returns a uint8 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func LookupUint8InRange(key string, minValue, maxValue, defaultValue uint8) (uint8, error) {
+	return defaultReader.LookupUint8InRange(key, minValue, maxValue, defaultValue)
+}
+
+// LookupUint16InRange returns a uint16 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func LookupUint16InRange(key string, minValue, maxValue, defaultValue uint16) (uint16, error) {
+	return defaultReader.LookupUint16InRange(key, minValue, maxValue, defaultValue)
+}
+
+// LookupUint32InRange returns a uint32 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func LookupUint32InRange(key string, minValue, maxValue, defaultValue uint32) (uint32, error) {
+	return defaultReader.LookupUint32InRange(key, minValue, maxValue, defaultValue)
+}
+
+// LookupUint64InRange returns a uint64 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func LookupUint64InRange(key string, minValue, maxValue, defaultValue uint64) (uint64, error) {
+	return defaultReader.LookupUint64InRange(key, minValue, maxValue, defaultValue)
+}
+
+// LookupFloat32InRange returns a float32 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func LookupFloat32InRange(key string, minValue, maxValue, defaultValue float32) (float32, error) {
+	return defaultReader.LookupFloat32InRange(key, minValue, maxValue, defaultValue)
+}
+
+// LookupFloat64InRange returns a float64 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func LookupFloat64InRange(key string, minValue, maxValue, defaultValue float64) (float64, error) {
+	return defaultReader.LookupFloat64InRange(key, minValue, maxValue, defaultValue)
+}
+
+// MustIntInRange returns a int value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func MustIntInRange(key string, minValue, maxValue int) int {
+	return defaultReader.MustIntInRange(key, minValue, maxValue)
+}
+
+// MustInt8InRange returns a int8 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func MustInt8InRange(key string, minValue, maxValue int8) int8 {
+	return defaultReader.MustInt8InRange(key, minValue, maxValue)
+}
+
+// MustInt16InRange returns a int16 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func MustInt16InRange(key string, minValue, maxValue int16) int16 {
+	return defaultReader.MustInt16InRange(key, minValue, maxValue)
+}
+
+// MustInt32InRange returns a int32 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func MustInt32InRange(key string, minValue, maxValue int32) int32 {
+	return defaultReader.MustInt32InRange(key, minValue, maxValue)
+}
+
+// MustInt64InRange returns a int64 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func MustInt64InRange(key string, minValue, maxValue int64) int64 {
+	return defaultReader.MustInt64InRange(key, minValue, maxValue)
+}
+
+// MustUintInRange returns a uint value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func MustUintInRange(key string, minValue, maxValue uint) uint {
+	return defaultReader.MustUintInRange(key, minValue, maxValue)
+}
+
+// MustUint8InRange returns a uint8 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func MustUint8InRange(key string, minValue, maxValue uint8) uint8 {
+	return defaultReader.MustUint8InRange(key, minValue, maxValue)
+}
+
+// MustUint16InRange returns a uint16 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func MustUint16InRange(key string, minValue, maxValue uint16) uint16 {
+	return defaultReader.MustUint16InRange(key, minValue, maxValue)
+}
+
+// MustUint32InRange returns a uint32 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func MustUint32InRange(key string, minValue, maxValue uint32) uint32 {
+	return defaultReader.MustUint32InRange(key, minValue, maxValue)
+}
+
+// MustUint64InRange returns a uint64 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func MustUint64InRange(key string, minValue, maxValue uint64) uint64 {
+	return defaultReader.MustUint64InRange(key, minValue, maxValue)
+}
+
+// MustFloat32InRange returns a float32 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func MustFloat32InRange(key string, minValue, maxValue float32) float32 {
+	return defaultReader.MustFloat32InRange(key, minValue, maxValue)
+}
+
+// MustFloat64InRange returns a float64 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func MustFloat64InRange(key string, minValue, maxValue float64) float64 {
+	return defaultReader.MustFloat64InRange(key, minValue, maxValue)
+}
+
+// GetIntInRange returns a int value between min and max inclusive from environment variable or the default value
+func (r *Reader) GetIntInRange(key string, minValue, maxValue, defaultValue int) int {
+	return GetInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// GetInt8InRange returns a int8 value between min and max inclusive from environment variable or the default value
+func (r *Reader) GetInt8InRange(key string, minValue, maxValue, defaultValue int8) int8 {
+	return GetInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// GetInt16InRange returns a int16 value between min and max inclusive from environment variable or the default value
+func (r *Reader) GetInt16InRange(key string, minValue, maxValue, defaultValue int16) int16 {
+	return GetInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// GetInt32InRange returns a int32 value between min and max inclusive from environment variable or the default value
+func (r *Reader) GetInt32InRange(key string, minValue, maxValue, defaultValue int32) int32 {
+	return GetInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// GetInt64InRange returns a int64 value between min and max inclusive from environment variable or the default value
+func (r *Reader) GetInt64InRange(key string, minValue, maxValue, defaultValue int64) int64 {
+	return GetInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// GetUintInRange returns a uint value between min and max inclusive from environment variable or the default value
+func (r *Reader) GetUintInRange(key string, minValue, maxValue, defaultValue uint) uint {
+	return GetInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// GetUint8InRange returns a uint8 value between min and max inclusive from environment variable or the default value
+func (r *Reader) GetUint8InRange(key string, minValue, maxValue, defaultValue uint8) uint8 {
+	return GetInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// GetUint16InRange returns a uint16 value between min and max inclusive from environment variable or the default value
+func (r *Reader) GetUint16InRange(key string, minValue, maxValue, defaultValue uint16) uint16 {
+	return GetInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// GetUint32InRange returns a uint32 value between min and max inclusive from environment variable or the default value
+func (r *Reader) GetUint32InRange(key string, minValue, maxValue, defaultValue uint32) uint32 {
+	return GetInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// GetUint64InRange returns a uint64 value between min and max inclusive from environment variable or the default value
+func (r *Reader) GetUint64InRange(key string, minValue, maxValue, defaultValue uint64) uint64 {
+	return GetInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// GetFloat32InRange returns a float32 value between min and max inclusive from environment variable or the default value
+func (r *Reader) GetFloat32InRange(key string, minValue, maxValue, defaultValue float32) float32 {
+	return GetInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// GetFloat64InRange returns a float64 value between min and max inclusive from environment variable or the default value
+func (r *Reader) GetFloat64InRange(key string, minValue, maxValue, defaultValue float64) float64 {
+	return GetInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// LookupIntInRange returns a int value between min and max inclusive from environment variable or the default value, see LookupInRange
+func (r *Reader) LookupIntInRange(key string, minValue, maxValue, defaultValue int) (int, error) {
+	return LookupInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// LookupInt8InRange returns a int8 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func (r *Reader) LookupInt8InRange(key string, minValue, maxValue, defaultValue int8) (int8, error) {
+	return LookupInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// LookupInt16InRange returns a int16 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func (r *Reader) LookupInt16InRange(key string, minValue, maxValue, defaultValue int16) (int16, error) {
+	return LookupInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// LookupInt32InRange returns a int32 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func (r *Reader) LookupInt32InRange(key string, minValue, maxValue, defaultValue int32) (int32, error) {
+	return LookupInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// LookupInt64InRange returns a int64 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func (r *Reader) LookupInt64InRange(key string, minValue, maxValue, defaultValue int64) (int64, error) {
+	return LookupInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// LookupUintInRange returns a uint value between min and max inclusive from environment variable or the default value, see LookupInRange
+func (r *Reader) LookupUintInRange(key string, minValue, maxValue, defaultValue uint) (uint, error) {
+	return LookupInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// LookupUint8InRange returns a uint8 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func (r *Reader) LookupUint8InRange(key string, minValue, maxValue, defaultValue uint8) (uint8, error) {
+	return LookupInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// LookupUint16InRange returns a uint16 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func (r *Reader) LookupUint16InRange(key string, minValue, maxValue, defaultValue uint16) (uint16, error) {
+	return LookupInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// LookupUint32InRange returns a uint32 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func (r *Reader) LookupUint32InRange(key string, minValue, maxValue, defaultValue uint32) (uint32, error) {
+	return LookupInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// LookupUint64InRange returns a uint64 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func (r *Reader) LookupUint64InRange(key string, minValue, maxValue, defaultValue uint64) (uint64, error) {
+	return LookupInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// LookupFloat32InRange returns a float32 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func (r *Reader) LookupFloat32InRange(key string, minValue, maxValue, defaultValue float32) (float32, error) {
+	return LookupInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// LookupFloat64InRange returns a float64 value between min and max inclusive from environment variable or the default value, see LookupInRange
+func (r *Reader) LookupFloat64InRange(key string, minValue, maxValue, defaultValue float64) (float64, error) {
+	return LookupInRangeFrom(r, key, minValue, maxValue, defaultValue)
+}
+
+// MustIntInRange returns a int value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustIntInRange(key string, minValue, maxValue int) int {
+	return MustInRangeFrom(r, key, minValue, maxValue)
+}
+
+// MustInt8InRange returns a int8 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustInt8InRange(key string, minValue, maxValue int8) int8 {
+	return MustInRangeFrom(r, key, minValue, maxValue)
+}
+
+// MustInt16InRange returns a int16 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustInt16InRange(key string, minValue, maxValue int16) int16 {
+	return MustInRangeFrom(r, key, minValue, maxValue)
+}
+
+// MustInt32InRange returns a int32 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustInt32InRange(key string, minValue, maxValue int32) int32 {
+	return MustInRangeFrom(r, key, minValue, maxValue)
+}
+
+// MustInt64InRange returns a int64 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustInt64InRange(key string, minValue, maxValue int64) int64 {
+	return MustInRangeFrom(r, key, minValue, maxValue)
+}
+
+// MustUintInRange returns a uint value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUintInRange(key string, minValue, maxValue uint) uint {
+	return MustInRangeFrom(r, key, minValue, maxValue)
+}
+
+// MustUint8InRange returns a uint8 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUint8InRange(key string, minValue, maxValue uint8) uint8 {
+	return MustInRangeFrom(r, key, minValue, maxValue)
+}
+
+// MustUint16InRange returns a uint16 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUint16InRange(key string, minValue, maxValue uint16) uint16 {
+	return MustInRangeFrom(r, key, minValue, maxValue)
+}
+
+// MustUint32InRange returns a uint32 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUint32InRange(key string, minValue, maxValue uint32) uint32 {
+	return MustInRangeFrom(r, key, minValue, maxValue)
+}
+
+// MustUint64InRange returns a uint64 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUint64InRange(key string, minValue, maxValue uint64) uint64 {
+	return MustInRangeFrom(r, key, minValue, maxValue)
+}
+
+// MustFloat32InRange returns a float32 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustFloat32InRange(key string, minValue, maxValue float32) float32 {
+	return MustInRangeFrom(r, key, minValue, maxValue)
+}
+
+// MustFloat64InRange returns a float64 value between min and max inclusive from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustFloat64InRange(key string, minValue, maxValue float64) float64 {
+	return MustInRangeFrom(r, key, minValue, maxValue)
+}
+
+// rangeError returns nil when err is a range error and the policy is RangeClamp, the parse functions already returned the clamped value
+func (r *Reader) rangeError(err error) error {
+	if r.rangePolicy == RangeClamp && errors.Is(err, strconv.ErrRange) {
+		return nil
+	}
+
+	return err
+}
+
+// clampsNegative reports whether s is a valid negative integer and the policy is RangeClamp, unsigned types clamp it to 0
+func (r *Reader) clampsNegative(s string) bool {
+	if r.rangePolicy != RangeClamp || !strings.HasPrefix(s, "-") {
+		return false
+	}
+
+	_, err := r.intOptions.parseInt(s, 64)
+	return err == nil || errors.Is(err, strconv.ErrRange)
+}
+
+// clampFloat replaces the infinities returned by strconv.ParseFloat on overflow with the largest finite value of bitSize
+func clampFloat(f float64, bitSize int) float64 {
+	switch {
+	case !math.IsInf(f, 0):
+		return f
+	case bitSize == 32:
+		return math.Copysign(math.MaxFloat32, f)
+	default:
+		return math.Copysign(math.MaxFloat64, f)
+	}
+}
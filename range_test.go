@@ -0,0 +1,148 @@
+package env
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestReaderWithRangePolicy(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]string{
+		"LEVEL":            "300",
+		"NEGATIVE":         "-300",
+		"NEGATIVES":        "-1,-99999999999999999999",
+		"NEGATIVE_INVALID": "-x",
+		"PORTS":            "80,70000",
+		"RATIO":            "1e39",
+		"BIG_RATIO":        "-1e309",
+		"INVALID":          "x",
+	}
+	reject := NewMapReader(values)
+	clamp := NewMapReader(values).WithRangePolicy(RangeClamp)
+
+	if result := reject.GetInt8("LEVEL", 1); result != 1 {
+		t.Errorf("GetInt8(\"LEVEL\", 1) with RangeReject: expected %d, actual %d", 1, result)
+	}
+	if _, err := reject.LookupInt8("LEVEL", 1); !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("LookupInt8(\"LEVEL\", 1) with RangeReject: expected strconv.ErrRange, actual %v", err)
+	}
+	if result := clamp.GetInt8("LEVEL", 1); result != math.MaxInt8 {
+		t.Errorf("GetInt8(\"LEVEL\", 1) with RangeClamp: expected %d, actual %d", math.MaxInt8, result)
+	}
+	if result := clamp.GetInt8("NEGATIVE", 1); result != math.MinInt8 {
+		t.Errorf("GetInt8(\"NEGATIVE\", 1) with RangeClamp: expected %d, actual %d", math.MinInt8, result)
+	}
+	if result := clamp.GetUint8("NEGATIVE", 1); result != 0 {
+		t.Errorf("GetUint8(\"NEGATIVE\", 1) with RangeClamp: expected %d, actual %d", 0, result)
+	}
+	if result := reject.GetUint8("NEGATIVE", 1); result != 1 {
+		t.Errorf("GetUint8(\"NEGATIVE\", 1) with RangeReject: expected %d, actual %d", 1, result)
+	}
+	if result := clamp.GetUint64Slice("NEGATIVES", ",", nil); !reflect.DeepEqual(result, []uint64{0, 0}) {
+		t.Errorf("GetUint64Slice(\"NEGATIVES\", \",\", nil) with RangeClamp: expected %v, actual %v", []uint64{0, 0}, result)
+	}
+	if result := clamp.GetUint("NEGATIVE_INVALID", 1); result != 1 {
+		t.Errorf("GetUint(\"NEGATIVE_INVALID\", 1) with RangeClamp: expected %d, actual %d", 1, result)
+	}
+	if result := clamp.GetUint16Slice("PORTS", ",", nil); !reflect.DeepEqual(result, []uint16{80, math.MaxUint16}) {
+		t.Errorf("GetUint16Slice(\"PORTS\", \",\", nil) with RangeClamp: expected %v, actual %v", []uint16{80, math.MaxUint16}, result)
+	}
+	if result := clamp.GetFloat32("RATIO", 0); result != math.MaxFloat32 {
+		t.Errorf("GetFloat32(\"RATIO\", 0) with RangeClamp: expected %v, actual %v", float32(math.MaxFloat32), result)
+	}
+	if result := clamp.GetFloat64("BIG_RATIO", 0); result != -math.MaxFloat64 {
+		t.Errorf("GetFloat64(\"BIG_RATIO\", 0) with RangeClamp: expected %v, actual %v", -math.MaxFloat64, result)
+	}
+	if _, err := clamp.LookupInt("INVALID", 0); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("LookupInt(\"INVALID\", 0) with RangeClamp: expected strconv.ErrSyntax, actual %v", err)
+	}
+
+	type config struct {
+		Level int8     `env:"LEVEL"`
+		Ports []uint16 `env:"PORTS"`
+		Ratio float32  `env:"RATIO"`
+		Floor uint     `env:"NEGATIVE"`
+	}
+	var cfg config
+	if err := clamp.Load(&cfg); err != nil || cfg.Level != math.MaxInt8 || cfg.Ports[1] != math.MaxUint16 || cfg.Ratio != math.MaxFloat32 || cfg.Floor != 0 {
+		t.Errorf("Load(&cfg) with RangeClamp: unexpected result %+v, %v", cfg, err)
+	}
+	if err := reject.Load(&cfg); err == nil {
+		t.Error("Load(&cfg) with RangeReject: expected an error")
+	}
+
+	infinite := NewMapReader(map[string]string{"RATIO": "Inf"})
+	for _, r := range []*Reader{infinite, infinite.WithRangePolicy(RangeClamp)} {
+		var cfg struct {
+			Ratio float64 `env:"RATIO"`
+		}
+		result := GetFrom(r, "RATIO", 0.0)
+		if err := r.Load(&cfg); err != nil || cfg.Ratio != result || !math.IsInf(result, 1) {
+			t.Errorf("Load(&cfg) with %v: expected Ratio %v like GetFrom, actual %v, %v", r.rangePolicy, result, cfg.Ratio, err)
+		}
+	}
+}
+
+func TestReaderGetInRange(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]string{
+		"WORKERS":  "8",
+		"TOO_MANY": "100",
+		"TOO_FEW":  "-1",
+		"OVERFLOW": "300",
+		"RATIO":    "1.5",
+		"NAN":      "NaN",
+	}
+	reject := NewMapReader(values)
+	clamp := NewMapReader(values).WithRangePolicy(RangeClamp)
+
+	var tests = []struct {
+		kind          string
+		reader        *Reader
+		key           string
+		expectedValue int8
+	}{
+		{"test-in-range", reject, "WORKERS", 8},
+		{"test-above-max", reject, "TOO_MANY", 4},
+		{"test-below-min", reject, "TOO_FEW", 4},
+		{"test-type-overflow", reject, "OVERFLOW", 4},
+		{"test-default-value", reject, "UNSET", 4},
+		{"test-clamp-above-max", clamp, "TOO_MANY", 64},
+		{"test-clamp-below-min", clamp, "TOO_FEW", 1},
+		{"test-clamp-type-overflow", clamp, "OVERFLOW", 64},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			if result := tt.reader.GetInt8InRange(tt.key, 1, 64, 4); result != tt.expectedValue {
+				t.Errorf("r.GetInt8InRange(\"%s\", 1, 64, 4): expected %d, actual %d", tt.key, tt.expectedValue, result)
+			}
+		})
+	}
+
+	_, err := reject.LookupIntInRange("TOO_MANY", 1, 64, 4)
+	if !errors.Is(err, ErrOutOfRange) || err.Error() != `env: parsing "100" from TOO_MANY as int: value out of range: 100 is not between 1 and 64` {
+		t.Errorf("r.LookupIntInRange(\"TOO_MANY\", 1, 64, 4): unexpected error %v", err)
+	}
+	if result := clamp.GetUintInRange("TOO_FEW", 1, 10, 5); result != 1 {
+		t.Errorf("r.GetUintInRange(\"TOO_FEW\", 1, 10, 5) with RangeClamp: expected %d, actual %d", 1, result)
+	}
+	if result := clamp.GetFloat64InRange("RATIO", 0, 1, 0.5); result != 1 {
+		t.Errorf("r.GetFloat64InRange(\"RATIO\", 0, 1, 0.5) with RangeClamp: expected %v, actual %v", 1, result)
+	}
+	if result := clamp.GetFloat64InRange("NAN", 0, 1, 0.5); result != 0.5 {
+		t.Errorf("r.GetFloat64InRange(\"NAN\", 0, 1, 0.5) with RangeClamp: expected %v, actual %v", 0.5, result)
+	}
+	if result := GetInRangeFrom(reject, "WORKERS", uint(1), 16, 4); result != 8 {
+		t.Errorf("GetInRangeFrom(reject, \"WORKERS\", 1, 16, 4): expected %d, actual %d", 8, result)
+	}
+	expectPanic(t, `env: parsing "-1" from TOO_FEW as uint64: strconv.ParseUint: parsing "-1": invalid syntax`, func() { reject.MustUint64InRange("TOO_FEW", 1, 10) })
+}
@@ -0,0 +1,181 @@
+package env
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Source looks up the values of environment variables
+type Source interface {
+	// Lookup returns the value of the variable named by the key and whether it is set
+	Lookup(key string) (string, bool)
+}
+
+// ErrSource is a Source that reports the errors that prevent reading a value, the Reader and Chain use LookupErr when available
+type ErrSource interface {
+	Source
+	// LookupErr returns the value of the variable named by the key, whether it is set and the error that prevented reading it
+	LookupErr(key string) (string, bool, error)
+}
+
+// lookupSource looks up the key in source with LookupErr if it is an ErrSource
+func lookupSource(source Source, key string) (string, bool, error) {
+	if s, ok := source.(ErrSource); ok {
+		return s.LookupErr(key)
+	}
+
+	value, ok := source.Lookup(key)
+	return value, ok, nil
+}
+
+// SourceFunc is an adapter to use an ordinary function as a Source
+type SourceFunc func(key string) (string, bool)
+
+// Lookup calls f(key)
+func (f SourceFunc) Lookup(key string) (string, bool) {
+	return f(key)
+}
+
+// OSSource is a Source backed by the process environment
+type OSSource struct{}
+
+// Lookup returns the value of the environment variable using os.LookupEnv
+func (OSSource) Lookup(key string) (string, bool) {
+	return os.LookupEnv(key)
+}
+
+// MapSource is a Source backed by a map, useful for tests that should not touch the process environment
+type MapSource map[string]string
+
+// Lookup returns the value of the key in the map
+func (m MapSource) Lookup(key string) (string, bool) {
+	value, ok := m[key]
+	return value, ok
+}
+
+// DirSource is a Source backed by a directory with one file per key, like the secrets mounted by Docker and Kubernetes
+// in /run/secrets. The value is the content of the file without the trailing newline, missing files are not set.
+// Unreadable files and files larger than DefaultFileLimit are reported by LookupErr as a *FileError.
+type DirSource string
+
+// Lookup returns the content of the file named by the key in the directory, files that cannot be read are not set
+func (d DirSource) Lookup(key string) (string, bool) {
+	value, ok, err := d.LookupErr(key)
+	return value, ok && err == nil
+}
+
+// LookupErr returns the content of the file named by the key in the directory, with a *FileError if it cannot be read
+func (d DirSource) LookupErr(key string) (string, bool, error) {
+	if key == "" || strings.ContainsAny(key, `/\`) || key == "." || key == ".." {
+		return "", false, nil
+	}
+
+	path := filepath.Join(string(d), key)
+	value, err := readFile(path, DefaultFileLimit)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, &FileError{Key: key, Path: path, Err: err}
+	}
+
+	return value, true, nil
+}
+
+// Reader reads values from a Source, its methods mirror the package level functions
+type Reader struct {
+	source    Source
+	prefix    string
+	expand    bool
+	fileLimit int64
+
+	duplicateKeys DuplicateKeyPolicy
+	sliceOptions  SliceOptions
+	intOptions    IntOptions
+	rangePolicy   RangePolicy
+	emptyPolicy   EmptyPolicy
+	mustBeSet     bool
+}
+
+// NewReader returns a Reader that reads values from source
+func NewReader(source Source) *Reader {
+	return &Reader{source: source}
+}
+
+// NewMapReader returns a Reader that reads values from a MapSource with the given values
+func NewMapReader(values map[string]string) *Reader {
+	return NewReader(MapSource(values))
+}
+
+// defaultReader is used by the package level functions
+var defaultReader = NewReader(OSSource{})
+
+// WithPrefix returns a reader that prepends the prefix to every key, ex: WithPrefix("BILLING_").GetInt("PORT", 8080) reads BILLING_PORT
+func WithPrefix(prefix string) *Reader {
+	return defaultReader.WithPrefix(prefix)
+}
+
+// WithPrefix returns a copy of the reader that prepends the prefix to every key, prefixes of nested readers are concatenated
+func (r *Reader) WithPrefix(prefix string) *Reader {
+	c := *r
+	c.prefix += prefix
+	return &c
+}
+
+// name returns the name of the environment variable for the key
+func (r *Reader) name(key string) string {
+	return r.prefix + key
+}
+
+// lookup returns the value of the environment variable for the key after applying the reader options,
+// an empty variable counts as unset for the file fallback when the policy is EmptyAsUnset.
+// An unset variable is a *NotSetError for the readers returned by required.
+func (r *Reader) lookup(key string) (string, bool, error) {
+	value, ok, err := r.resolve(key)
+	if err == nil && !ok && r.mustBeSet {
+		return "", false, &NotSetError{Key: r.name(key)}
+	}
+
+	return value, ok, err
+}
+
+// resolve returns the value of the environment variable for the key, see lookup
+func (r *Reader) resolve(key string) (string, bool, error) {
+	return r.resolveName(r.name(key), nil)
+}
+
+// resolveName returns the value of the environment variable with the name, which is already prefixed, with the file
+// fallback, expansion and empty policy of the reader. stack holds the names of the variables referencing it while expanding.
+func (r *Reader) resolveName(name string, stack []string) (string, bool, error) {
+	value, ok, err := lookupSource(r.source, name)
+	if err != nil {
+		return "", false, err
+	}
+	if ok && value == "" && r.emptyPolicy == EmptyAsUnset {
+		ok = false
+	}
+
+	switch {
+	case !ok && r.fileLimit > 0:
+		value, ok, err = r.lookupFile(name)
+	case ok && r.expand:
+		nextStack := make([]string, len(stack), len(stack)+1)
+		copy(nextStack, stack)
+		value, err = r.expandValue(value, append(nextStack, name))
+	}
+	if err != nil || !ok || value != "" {
+		return value, ok, err
+	}
+
+	switch r.emptyPolicy {
+	case EmptyAsUnset:
+		return "", false, nil
+	case EmptyReject:
+		return "", true, &EmptyError{Key: name}
+	}
+
+	return value, true, nil
+}
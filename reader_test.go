@@ -0,0 +1,138 @@
+package env
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReader(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"PORT":    "8080",
+		"HOSTS":   "a,b",
+		"TIMEOUT": "1m30s",
+		"RETRIES": "três",
+	})
+
+	if result := r.GetInt("PORT", 80); result != 8080 {
+		t.Errorf("r.GetInt(\"PORT\", 80): expected %d, actual %d", 8080, result)
+	}
+	if result := r.GetInt("WORKERS", 4); result != 4 {
+		t.Errorf("r.GetInt(\"WORKERS\", 4): expected %d, actual %d", 4, result)
+	}
+	if result := r.GetStringSlice("HOSTS", ",", nil); !reflect.DeepEqual(result, []string{"a", "b"}) {
+		t.Errorf("r.GetStringSlice(\"HOSTS\", \",\", nil): expected %#v, actual %#v", []string{"a", "b"}, result)
+	}
+	if result := GetFrom(r, "TIMEOUT", time.Second); result != 90*time.Second {
+		t.Errorf("GetFrom(r, \"TIMEOUT\", 1s): expected %v, actual %v", 90*time.Second, result)
+	}
+	if result := r.MustString("PORT"); result != "8080" {
+		t.Errorf("r.MustString(\"PORT\"): expected %q, actual %q", "8080", result)
+	}
+
+	var parseErr *ParseError
+	if _, err := r.LookupInt("RETRIES", 3); !errors.As(err, &parseErr) || parseErr.Key != "RETRIES" {
+		t.Errorf("r.LookupInt(\"RETRIES\", 3): expected *ParseError, actual %v", err)
+	}
+
+	var cfg struct {
+		Port    uint16        `env:"PORT"`
+		Timeout time.Duration `env:"TIMEOUT"`
+	}
+	if err := r.Load(&cfg); err != nil {
+		t.Fatalf("r.Load(&cfg): unexpected error %v", err)
+	}
+	if cfg.Port != 8080 || cfg.Timeout != 90*time.Second {
+		t.Errorf("r.Load(&cfg): unexpected result %#v", cfg)
+	}
+}
+
+func TestOSSource(t *testing.T) {
+	t.Setenv("OS_SOURCE", "value")
+
+	if value, ok := (OSSource{}).Lookup("OS_SOURCE"); !ok || value != "value" {
+		t.Errorf("OSSource{}.Lookup(\"OS_SOURCE\"): expected %q, actual %q", "value", value)
+	}
+	if _, ok := (OSSource{}).Lookup("OS_SOURCE_UNSET"); ok {
+		t.Error("OSSource{}.Lookup(\"OS_SOURCE_UNSET\"): expected unset")
+	}
+}
+
+func TestMapSource(t *testing.T) {
+	t.Parallel()
+
+	source := MapSource{"KEY": "value", "EMPTY": ""}
+
+	var tests = []struct {
+		kind          string
+		key           string
+		expectedValue string
+		expectedOk    bool
+	}{
+		{"test-value", "KEY", "value", true},
+		{"test-empty-value", "EMPTY", "", true},
+		{"test-unset", "UNSET", "", false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			value, ok := source.Lookup(tt.key)
+			if value != tt.expectedValue || ok != tt.expectedOk {
+				t.Errorf("source.Lookup(\"%s\"): expected (%q, %v), actual (%q, %v)", tt.key, tt.expectedValue, tt.expectedOk, value, ok)
+			}
+		})
+	}
+}
+
+func TestNewMapReader(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"DURATION": "10",
+		"FLAGS":    "true,false,true",
+	})
+
+	if result := r.GetDuration("DURATION", 1, time.Second); result != 10*time.Second {
+		t.Errorf("r.GetDuration(\"DURATION\", 1, 1s): expected %v, actual %v", 10*time.Second, result)
+	}
+	if result := r.GetBoolSlice("FLAGS", ",", nil); !reflect.DeepEqual(result, []bool{true, false, true}) {
+		t.Errorf("r.GetBoolSlice(\"FLAGS\", \",\", nil): expected %#v, actual %#v", []bool{true, false, true}, result)
+	}
+	if result := GetString("DURATION", "unset"); result != "unset" {
+		t.Errorf("GetString(\"DURATION\", \"unset\"): expected the process environment to be untouched, actual %q", result)
+	}
+}
+
+func TestWithPrefix(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"PORT":               "80",
+		"BILLING_PORT":       "8080",
+		"BILLING_DB_PORT":    "5432",
+		"BILLING_DB_INVALID": "invalid",
+	})
+	billing := r.WithPrefix("BILLING_")
+
+	if result := billing.GetInt("PORT", 0); result != 8080 {
+		t.Errorf("billing.GetInt(\"PORT\", 0): expected %d, actual %d", 8080, result)
+	}
+	if result := billing.WithPrefix("DB_").GetInt("PORT", 0); result != 5432 {
+		t.Errorf("billing.WithPrefix(\"DB_\").GetInt(\"PORT\", 0): expected %d, actual %d", 5432, result)
+	}
+	if result := r.GetInt("PORT", 0); result != 80 {
+		t.Errorf("r.GetInt(\"PORT\", 0): expected %d, actual %d", 80, result)
+	}
+
+	var parseErr *ParseError
+	if _, err := billing.WithPrefix("DB_").LookupInt("INVALID", 0); !errors.As(err, &parseErr) || parseErr.Key != "BILLING_DB_INVALID" {
+		t.Errorf("LookupInt(\"INVALID\", 0): expected *ParseError for BILLING_DB_INVALID, actual %v", err)
+	}
+	expectPanic(t, "env: BILLING_HOST is not set", func() { billing.MustString("HOST") })
+}
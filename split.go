@@ -0,0 +1,97 @@
+package env
+
+import (
+	"errors"
+	"strings"
+)
+
+// SliceOptions configures how the slice functions split values into elements, the zero value splits with strings.Split
+type SliceOptions struct {
+	Quotes    bool // elements can be enclosed in double quotes to contain the separator, "" is a literal quote inside quotes
+	Escapes   bool // a backslash escapes the next character, including the separator and quotes
+	TrimSpace bool // leading and trailing whitespaces of unquoted elements are removed
+	DropEmpty bool // empty elements are dropped
+}
+
+// WithSliceOptions returns a reader that splits the values of every slice and map function with the options
+func WithSliceOptions(options SliceOptions) *Reader {
+	return defaultReader.WithSliceOptions(options)
+}
+
+// WithSliceOptions returns a copy of the reader that splits the values of every slice and map function with the options
+func (r *Reader) WithSliceOptions(options SliceOptions) *Reader {
+	c := *r
+	c.sliceOptions = options
+	return &c
+}
+
+// split splits s into elements separated by sep according to the options. An empty sep splits after each UTF-8 sequence
+// like strings.Split, quotes and escapes do not apply and the elements are only trimmed and dropped.
+func (o SliceOptions) split(s, sep string) ([]string, error) {
+	if o == (SliceOptions{}) {
+		return strings.Split(s, sep), nil
+	}
+	if sep == "" {
+		var elements []string
+		for _, element := range strings.Split(s, "") {
+			if o.TrimSpace {
+				element = strings.TrimSpace(element)
+			}
+			if element != "" || !o.DropEmpty {
+				elements = append(elements, element)
+			}
+		}
+		return elements, nil
+	}
+
+	var elements []string
+	var sb strings.Builder
+	inQuotes, quoted := false, false
+
+	appendElement := func() {
+		element := sb.String()
+		if o.TrimSpace && !quoted {
+			element = strings.TrimSpace(element)
+		}
+		if element != "" || !o.DropEmpty {
+			elements = append(elements, element)
+		}
+		sb.Reset()
+		quoted = false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case o.Escapes && c == '\\' && i+1 < len(s):
+			i++
+			sb.WriteByte(s[i])
+		case inQuotes && c == '"' && i+1 < len(s) && s[i+1] == '"':
+			i++
+			sb.WriteByte('"')
+		case inQuotes && c == '"':
+			inQuotes = false
+		case inQuotes:
+			sb.WriteByte(c)
+		case strings.HasPrefix(s[i:], sep):
+			appendElement()
+			i += len(sep) - 1
+		case quoted:
+			if c != ' ' && c != '\t' {
+				return nil, errors.New("unexpected character after quoted element")
+			}
+		case o.Quotes && c == '"' && strings.TrimSpace(sb.String()) == "":
+			sb.Reset()
+			inQuotes, quoted = true, true
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	if inQuotes {
+		return nil, errors.New("unterminated quoted element")
+	}
+	appendElement()
+
+	return elements, nil
+}
@@ -0,0 +1,90 @@
+package env
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSliceOptionsSplit(t *testing.T) {
+	t.Parallel()
+
+	all := SliceOptions{Quotes: true, Escapes: true, TrimSpace: true, DropEmpty: true}
+
+	var tests = []struct {
+		kind          string
+		options       SliceOptions
+		value         string
+		sep           string
+		expectedValue []string
+		expectedError bool
+	}{
+		{"test-zero-options", SliceOptions{}, `a, "b,c"`, ",", []string{"a", ` "b`, `c"`}, false},
+		{"test-quotes", SliceOptions{Quotes: true}, `a,"b,c",d`, ",", []string{"a", "b,c", "d"}, false},
+		{"test-doubled-quote", SliceOptions{Quotes: true}, `"say ""hi""",x`, ",", []string{`say "hi"`, "x"}, false},
+		{"test-quote-inside-element", SliceOptions{Quotes: true}, `a"b,c`, ",", []string{`a"b`, "c"}, false},
+		{"test-escapes", SliceOptions{Escapes: true}, `a\,b,c\\`, ",", []string{"a,b", `c\`}, false},
+		{"test-trim-space", SliceOptions{TrimSpace: true}, " a , b ,c", ",", []string{"a", "b", "c"}, false},
+		{"test-trim-keeps-quoted", all, `  " a " , b`, ",", []string{" a ", "b"}, false},
+		{"test-drop-empty", SliceOptions{DropEmpty: true}, ",a,,b,", ",", []string{"a", "b"}, false},
+		{"test-drop-empty-value", SliceOptions{DropEmpty: true}, "", ",", nil, false},
+		{"test-multi-char-sep", all, `a || "b || c"`, "||", []string{"a", "b || c"}, false},
+		{"test-empty-sep", SliceOptions{}, "a b", "", []string{"a", " ", "b"}, false},
+		{"test-empty-sep-with-options", SliceOptions{Quotes: true, TrimSpace: true}, `a "b`, "", []string{"a", "", `"`, "b"}, false},
+		{"test-empty-sep-drop-empty", all, "a b", "", []string{"a", "b"}, false},
+		{"test-unterminated-quote", SliceOptions{Quotes: true}, `a,"b`, ",", nil, true},
+		{"test-text-after-quote", SliceOptions{Quotes: true}, `"a"b,c`, ",", nil, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := tt.options.split(tt.value, tt.sep)
+			if !reflect.DeepEqual(result, tt.expectedValue) {
+				t.Errorf("split(%q, %q): expected %q, actual %q", tt.value, tt.sep, tt.expectedValue, result)
+			}
+			if (err != nil) != tt.expectedError {
+				t.Errorf("split(%q, %q): expected error %v, actual %v", tt.value, tt.sep, tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestReaderWithSliceOptions(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"FILTERS": `"status IN (1,2)", name = 'x' ,`,
+		"PORTS":   " 80, 443 ,, 8080 ",
+		"LABELS":  `team=core,"note=a,b"`,
+		"BROKEN":  `"a,b`,
+	}).WithSliceOptions(SliceOptions{Quotes: true, Escapes: true, TrimSpace: true, DropEmpty: true})
+
+	expectedFilters := []string{"status IN (1,2)", "name = 'x'"}
+	if result := r.GetStringSlice("FILTERS", ",", nil); !reflect.DeepEqual(result, expectedFilters) {
+		t.Errorf("r.GetStringSlice(\"FILTERS\", \",\", nil): expected %q, actual %q", expectedFilters, result)
+	}
+	expectedPorts := []int{80, 443, 8080}
+	if result := r.GetIntSlice("PORTS", ",", nil); !reflect.DeepEqual(result, expectedPorts) {
+		t.Errorf("r.GetIntSlice(\"PORTS\", \",\", nil): expected %v, actual %v", expectedPorts, result)
+	}
+	expectedLabels := map[string]string{"team": "core", "note": "a,b"}
+	if result := r.GetStringMap("LABELS", ",", "=", nil); !reflect.DeepEqual(result, expectedLabels) {
+		t.Errorf("r.GetStringMap(\"LABELS\", \",\", \"=\", nil): expected %v, actual %v", expectedLabels, result)
+	}
+
+	var parseErr *ParseError
+	if _, err := r.LookupStringSlice("BROKEN", ",", nil); !errors.As(err, &parseErr) || parseErr.Type != "[]string" {
+		t.Errorf("r.LookupStringSlice(\"BROKEN\", \",\", nil): expected *ParseError, actual %v", err)
+	}
+
+	type config struct {
+		Ports []int `env:"PORTS"`
+	}
+	var cfg config
+	if err := r.Load(&cfg); err != nil || !reflect.DeepEqual(cfg.Ports, expectedPorts) {
+		t.Errorf("r.Load(&cfg): expected %v, actual %v, %v", expectedPorts, cfg.Ports, err)
+	}
+}
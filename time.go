@@ -0,0 +1,144 @@
+package env
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetTime returns a time.Time value parsed with the layout from environment variable or the default value, an empty layout means time.RFC3339
+func GetTime(key, layout string, defaultValue time.Time) time.Time {
+	return defaultReader.GetTime(key, layout, defaultValue)
+}
+
+// GetUnixTime returns a time.Time value from a Unix timestamp in seconds, ex: 1700000000 or 1700000000.5, from environment variable or the default value
+func GetUnixTime(key string, defaultValue time.Time) time.Time {
+	return defaultReader.GetUnixTime(key, defaultValue)
+}
+
+// GetLocation returns a *time.Location from an IANA time zone name, ex: America/Sao_Paulo, from environment variable or the default value
+func GetLocation(key string, defaultValue *time.Location) *time.Location {
+	return defaultReader.GetLocation(key, defaultValue)
+}
+
+// LookupTime returns a time.Time value parsed with the layout from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupTime(key, layout string, defaultValue time.Time) (time.Time, error) {
+	return defaultReader.LookupTime(key, layout, defaultValue)
+}
+
+// LookupUnixTime returns a time.Time value from a Unix timestamp in seconds from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupUnixTime(key string, defaultValue time.Time) (time.Time, error) {
+	return defaultReader.LookupUnixTime(key, defaultValue)
+}
+
+// LookupLocation returns a *time.Location from an IANA time zone name from environment variable or the default value, with a *ParseError if the value is invalid
+func LookupLocation(key string, defaultValue *time.Location) (*time.Location, error) {
+	return defaultReader.LookupLocation(key, defaultValue)
+}
+
+// MustTime returns a time.Time value parsed with the layout from environment variable, it panics if the variable is not set or invalid
+func MustTime(key, layout string) time.Time {
+	return defaultReader.MustTime(key, layout)
+}
+
+// MustUnixTime returns a time.Time value from a Unix timestamp in seconds from environment variable, it panics if the variable is not set or invalid
+func MustUnixTime(key string) time.Time {
+	return defaultReader.MustUnixTime(key)
+}
+
+// MustLocation returns a *time.Location from an IANA time zone name from environment variable, it panics if the variable is not set or invalid
+func MustLocation(key string) *time.Location {
+	return defaultReader.MustLocation(key)
+}
+
+// GetTime returns a time.Time value parsed with the layout from environment variable or the default value, see GetTime
+func (r *Reader) GetTime(key, layout string, defaultValue time.Time) time.Time {
+	value, _ := r.LookupTime(key, layout, defaultValue)
+	return value
+}
+
+// GetUnixTime returns a time.Time value from a Unix timestamp in seconds from environment variable or the default value
+func (r *Reader) GetUnixTime(key string, defaultValue time.Time) time.Time {
+	value, _ := r.LookupUnixTime(key, defaultValue)
+	return value
+}
+
+// GetLocation returns a *time.Location from an IANA time zone name from environment variable or the default value
+func (r *Reader) GetLocation(key string, defaultValue *time.Location) *time.Location {
+	value, _ := r.LookupLocation(key, defaultValue)
+	return value
+}
+
+// LookupTime returns a time.Time value parsed with the layout from environment variable or the default value, see LookupTime
+func (r *Reader) LookupTime(key, layout string, defaultValue time.Time) (time.Time, error) {
+	if layout == "" {
+		layout = time.RFC3339
+	}
+
+	return lookupWith(r, key, defaultValue, "time.Time", func(s string) (time.Time, error) {
+		return time.Parse(layout, s)
+	})
+}
+
+// LookupUnixTime returns a time.Time value from a Unix timestamp in seconds from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupUnixTime(key string, defaultValue time.Time) (time.Time, error) {
+	return lookupWith(r, key, defaultValue, "unix timestamp", parseUnixTime)
+}
+
+// LookupLocation returns a *time.Location from an IANA time zone name from environment variable or the default value, with a *ParseError if the value is invalid
+func (r *Reader) LookupLocation(key string, defaultValue *time.Location) (*time.Location, error) {
+	return lookupWith(r, key, defaultValue, "*time.Location", parseLocation)
+}
+
+// MustTime returns a time.Time value parsed with the layout from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustTime(key, layout string) time.Time {
+	return must(r.required().LookupTime(key, layout, time.Time{}))
+}
+
+// MustUnixTime returns a time.Time value from a Unix timestamp in seconds from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustUnixTime(key string) time.Time {
+	return must(r.required().LookupUnixTime(key, time.Time{}))
+}
+
+// MustLocation returns a *time.Location from an IANA time zone name from environment variable, it panics if the variable is not set or invalid
+func (r *Reader) MustLocation(key string) *time.Location {
+	return must(r.required().LookupLocation(key, nil))
+}
+
+// parseUnixTime parses a Unix timestamp in seconds with an optional fraction of up to nanosecond precision, both can be negative
+func parseUnixTime(s string) (time.Time, error) {
+	seconds, fraction, hasFraction := strings.Cut(s, ".")
+
+	sec, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !hasFraction {
+		return time.Unix(sec, 0), nil
+	}
+
+	if fraction == "" || len(fraction) > 9 {
+		return time.Time{}, &strconv.NumError{Func: "parseUnixTime", Num: s, Err: strconv.ErrSyntax}
+	}
+	nsec, err := strconv.ParseUint(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// the fraction of a negative timestamp moves it further from the epoch, -1.5 is 2 seconds before plus 0.5
+	if strings.HasPrefix(seconds, "-") && nsec > 0 {
+		return time.Unix(sec-1, int64(1e9-nsec)), nil
+	}
+
+	return time.Unix(sec, int64(nsec)), nil
+}
+
+// parseLocation is time.LoadLocation rejecting the empty name, which it would load as UTC
+func parseLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return nil, errors.New("empty time zone name")
+	}
+
+	return time.LoadLocation(name)
+}
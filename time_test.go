@@ -0,0 +1,118 @@
+package env
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestReaderGetTime(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"CUTOVER":     "2026-03-01T12:00:00Z",
+		"MAINTENANCE": "2026-03-01 02:00",
+		"INVALID":     "tomorrow",
+	})
+	defaultValue := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var tests = []struct {
+		kind          string
+		key           string
+		layout        string
+		expectedValue time.Time
+	}{
+		{"test-rfc3339", "CUTOVER", "", time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)},
+		{"test-custom-layout", "MAINTENANCE", "2006-01-02 15:04", time.Date(2026, 3, 1, 2, 0, 0, 0, time.UTC)},
+		{"test-layout-mismatch", "MAINTENANCE", time.RFC3339, defaultValue},
+		{"test-invalid-value", "INVALID", "", defaultValue},
+		{"test-default-value", "UNSET", "", defaultValue},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			if result := r.GetTime(tt.key, tt.layout, defaultValue); !result.Equal(tt.expectedValue) {
+				t.Errorf("r.GetTime(\"%s\", %q, %v): expected %v, actual %v", tt.key, tt.layout, defaultValue, tt.expectedValue, result)
+			}
+		})
+	}
+
+	var parseErr *ParseError
+	if _, err := r.LookupTime("INVALID", "", defaultValue); !errors.As(err, &parseErr) || parseErr.Type != "time.Time" {
+		t.Errorf("r.LookupTime(\"INVALID\", \"\", %v): expected *ParseError, actual %v", defaultValue, err)
+	}
+	expectPanic(t, "env: UNSET is not set", func() { r.MustTime("UNSET", "") })
+}
+
+func TestReaderGetUnixTime(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"SECONDS":           "1700000000",
+		"FRACTION":          "1700000000.25",
+		"NANOSECONDS":       "1700000000.000000001",
+		"NEGATIVE":          "-86400",
+		"INVALID":           "1700000000.",
+		"TOO_PRECISE":       "1700000000.0000000001",
+		"NEGATIVE_FRACTION": "-1.5",
+		"NEGATIVE_ZERO":     "-0.25",
+		"NEGATIVE_ROUND":    "-2.0",
+	})
+	defaultValue := time.Unix(0, 0)
+
+	var tests = []struct {
+		kind          string
+		key           string
+		expectedValue time.Time
+	}{
+		{"test-seconds", "SECONDS", time.Unix(1700000000, 0)},
+		{"test-fraction", "FRACTION", time.Unix(1700000000, 250000000)},
+		{"test-nanoseconds", "NANOSECONDS", time.Unix(1700000000, 1)},
+		{"test-negative", "NEGATIVE", time.Unix(-86400, 0)},
+		{"test-empty-fraction", "INVALID", defaultValue},
+		{"test-too-precise", "TOO_PRECISE", defaultValue},
+		{"test-negative-fraction", "NEGATIVE_FRACTION", time.Unix(-2, 500000000)},
+		{"test-negative-zero-seconds", "NEGATIVE_ZERO", time.Unix(-1, 750000000)},
+		{"test-negative-zero-fraction", "NEGATIVE_ROUND", time.Unix(-2, 0)},
+		{"test-default-value", "UNSET", defaultValue},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+
+			if result := r.GetUnixTime(tt.key, defaultValue); !result.Equal(tt.expectedValue) {
+				t.Errorf("r.GetUnixTime(\"%s\", %v): expected %v, actual %v", tt.key, defaultValue, tt.expectedValue, result)
+			}
+		})
+	}
+}
+
+func TestReaderGetLocation(t *testing.T) {
+	t.Parallel()
+
+	r := NewMapReader(map[string]string{
+		"TZ":      "UTC",
+		"INVALID": "Mars/Olympus_Mons",
+		"EMPTY":   "",
+	})
+
+	if result := r.GetLocation("TZ", time.Local); result.String() != "UTC" {
+		t.Errorf("r.GetLocation(\"TZ\", time.Local): expected %q, actual %q", "UTC", result)
+	}
+	if result := r.GetLocation("INVALID", time.Local); result != time.Local {
+		t.Errorf("r.GetLocation(\"INVALID\", time.Local): expected %v, actual %v", time.Local, result)
+	}
+
+	var parseErr *ParseError
+	if _, err := r.LookupLocation("INVALID", nil); !errors.As(err, &parseErr) || parseErr.Value != "Mars/Olympus_Mons" {
+		t.Errorf("r.LookupLocation(\"INVALID\", nil): expected *ParseError, actual %v", err)
+	}
+	if result, err := r.LookupLocation("EMPTY", time.Local); !errors.As(err, &parseErr) || result != time.Local {
+		t.Errorf("r.LookupLocation(\"EMPTY\", time.Local): expected *ParseError, actual %v, %v", result, err)
+	}
+}